@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"github.com/6ftclaud/qbittorrent-go/cmd/qbt/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var categoryCmd = &cobra.Command{
+	Use:   "category",
+	Short: "Manage categories",
+}
+
+var categoryListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List categories",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		categories, err := client.GetCategories()
+		if err != nil {
+			return err
+		}
+		return output.Write(cmd.OutOrStdout(), outputFormat(cmd), categories)
+	},
+}
+
+var categoryAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Create a category",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		savePath, _ := cmd.Flags().GetString("save-path")
+		_, err = client.CreateCategory(map[string]string{"category": args[0], "savePath": savePath})
+		return err
+	},
+}
+
+var categoryEditCmd = &cobra.Command{
+	Use:   "edit <name>",
+	Short: "Edit a category's save path",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		savePath, _ := cmd.Flags().GetString("save-path")
+		_, err = client.EditCategory(map[string]string{"category": args[0], "savePath": savePath})
+		return err
+	},
+}
+
+var categoryRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove a category",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.RemoveCategory(args[0])
+		return err
+	},
+}
+
+func init() {
+	categoryAddCmd.Flags().String("save-path", "", "save location for torrents in this category")
+	categoryEditCmd.Flags().String("save-path", "", "new save location for torrents in this category")
+
+	categoryCmd.AddCommand(categoryListCmd, categoryAddCmd, categoryEditCmd, categoryRemoveCmd)
+	rootCmd.AddCommand(categoryCmd)
+}
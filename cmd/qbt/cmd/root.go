@@ -0,0 +1,157 @@
+/*
+Package cmd implements the qbt command-line client on top of cobra, driving
+the qbittorrent-go client library against a qBittorrent WebUI.
+
+Connection details are resolved with flags, then QBT_* environment
+variables, then a named profile from ~/.config/qbt/config.yaml, in that
+order of precedence:
+
+	profiles:
+	  default:
+	    host: localhost
+	    port: 8080
+	    username: admin
+	    password: adminadmin
+	  seedbox:
+	    host: https://seedbox.example.com
+	    username: admin
+	    password: hunter2
+
+Shell completion scripts are available via the `completion` subcommand
+cobra generates automatically for every command tree.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	api "github.com/6ftclaud/qbittorrent-go/modules"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// profileConfig is a single named server profile in the qbt config file.
+type profileConfig struct {
+	Host          string `mapstructure:"host"`
+	Port          int    `mapstructure:"port"`
+	Username      string `mapstructure:"username"`
+	Password      string `mapstructure:"password"`
+	TLS           bool   `mapstructure:"tls"`
+	TLSSkipVerify bool   `mapstructure:"tls_skip_verify"`
+}
+
+var rootCmd = &cobra.Command{
+	Use:           "qbt",
+	Short:         "qbt is a command-line client for the qBittorrent WebUI API",
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+// Execute runs the root command; it's the only symbol cmd/qbt/main.go needs.
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func init() {
+	cobra.OnInitialize(initConfig)
+
+	rootCmd.PersistentFlags().String("config", "", "config file (default $HOME/.config/qbt/config.yaml)")
+	rootCmd.PersistentFlags().String("profile", "default", "named server profile from the config file")
+	rootCmd.PersistentFlags().String("host", "", "qBittorrent WebUI host, overrides the profile")
+	rootCmd.PersistentFlags().Int("port", 0, "qBittorrent WebUI port, overrides the profile")
+	rootCmd.PersistentFlags().String("username", "", "WebUI username, overrides the profile")
+	rootCmd.PersistentFlags().String("password", "", "WebUI password, overrides the profile")
+	rootCmd.PersistentFlags().Bool("tls", false, "connect over https, overrides the profile")
+	rootCmd.PersistentFlags().StringP("output", "o", "table", "output format: table, json or yaml")
+}
+
+// initConfig points viper at the config file and enables QBT_*-prefixed
+// environment variables. A missing config file isn't an error: profiles
+// then just default to their zero values, to be filled in by flags/env.
+func initConfig() {
+	if cfgFile, _ := rootCmd.PersistentFlags().GetString("config"); cfgFile != "" {
+		viper.SetConfigFile(cfgFile)
+	} else {
+		if home, err := os.UserHomeDir(); err == nil {
+			viper.AddConfigPath(filepath.Join(home, ".config", "qbt"))
+		}
+		viper.SetConfigName("config")
+		viper.SetConfigType("yaml")
+	}
+
+	viper.SetEnvPrefix("QBT")
+	viper.AutomaticEnv()
+
+	_ = viper.ReadInConfig()
+}
+
+// stringSetting resolves a string field with flag > env > profile precedence.
+func stringSetting(cmd *cobra.Command, flag string) string {
+	if cmd.Flags().Changed(flag) {
+		v, _ := cmd.Flags().GetString(flag)
+		return v
+	}
+	return viper.GetString(flag)
+}
+
+// newClient resolves the selected profile, overridden by any --host/
+// --username/... flags or QBT_* environment variables, builds a Client and
+// logs in when credentials are present.
+func newClient(cmd *cobra.Command) (*api.Client, error) {
+	var profiles map[string]profileConfig
+	if err := viper.UnmarshalKey("profiles", &profiles); err != nil {
+		return nil, fmt.Errorf("parsing profiles from config: %w", err)
+	}
+
+	profileName, _ := cmd.Flags().GetString("profile")
+	profile := profiles[profileName]
+
+	settings := api.Settings{
+		Host:          profile.Host,
+		Port:          profile.Port,
+		Username:      profile.Username,
+		Password:      profile.Password,
+		TLS:           profile.TLS,
+		TLSSkipVerify: profile.TLSSkipVerify,
+	}
+
+	if v := stringSetting(cmd, "host"); v != "" {
+		settings.Host = v
+	}
+	if cmd.Flags().Changed("port") {
+		settings.Port, _ = cmd.Flags().GetInt("port")
+	} else if p := viper.GetInt("port"); p != 0 {
+		settings.Port = p
+	}
+	if v := stringSetting(cmd, "username"); v != "" {
+		settings.Username = v
+	}
+	if v := stringSetting(cmd, "password"); v != "" {
+		settings.Password = v
+	}
+	if cmd.Flags().Changed("tls") {
+		settings.TLS, _ = cmd.Flags().GetBool("tls")
+	} else if viper.GetBool("tls") {
+		settings.TLS = true
+	}
+
+	if settings.Host == "" {
+		return nil, fmt.Errorf("no host configured for profile %q: set --host, QBT_HOST, or profiles.%s.host in the config file", profileName, profileName)
+	}
+
+	client := api.NewClientWithSettings(settings)
+	if settings.Username != "" {
+		if _, err := client.Login(settings.Username, settings.Password); err != nil {
+			return nil, fmt.Errorf("login: %w", err)
+		}
+	}
+	return client, nil
+}
+
+// outputFormat returns the -o/--output flag's value for cmd.
+func outputFormat(cmd *cobra.Command) string {
+	format, _ := cmd.Flags().GetString("output")
+	return format
+}
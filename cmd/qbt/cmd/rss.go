@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	api "github.com/6ftclaud/qbittorrent-go/modules"
+
+	"github.com/6ftclaud/qbittorrent-go/cmd/qbt/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var rssCmd = &cobra.Command{
+	Use:   "rss",
+	Short: "Manage RSS feeds and auto-download rules",
+}
+
+var rssFeedCmd = &cobra.Command{
+	Use:   "feed",
+	Short: "Manage RSS feeds",
+}
+
+var rssFeedListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List feeds and folders",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		withData, _ := cmd.Flags().GetBool("data")
+		items, err := client.GetAllItems(withData)
+		if err != nil {
+			return err
+		}
+		return output.Write(cmd.OutOrStdout(), outputFormat(cmd), items)
+	},
+}
+
+var rssFeedAddCmd = &cobra.Command{
+	Use:   "add <url> [path]",
+	Short: "Subscribe to an RSS feed",
+	Args:  cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		var path string
+		if len(args) == 2 {
+			path = args[1]
+		}
+		_, err = client.AddFeed(args[0], path)
+		return err
+	},
+}
+
+var rssFeedRemoveCmd = &cobra.Command{
+	Use:   "remove <path>",
+	Short: "Remove a feed or folder",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.RemoveItem(args[0])
+		return err
+	},
+}
+
+var rssFeedMoveCmd = &cobra.Command{
+	Use:   "move <path> <new-path>",
+	Short: "Move or rename a feed or folder",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.MoveItem(args[0], args[1])
+		return err
+	},
+}
+
+var rssFeedRefreshCmd = &cobra.Command{
+	Use:   "refresh <path>",
+	Short: "Fetch a feed from its source immediately",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.RefreshItem(args[0])
+		return err
+	},
+}
+
+var rssFeedMarkReadCmd = &cobra.Command{
+	Use:   "mark-read <path> [article-id]",
+	Short: "Mark an article, or an entire feed, as read",
+	Args:  cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		var articleID string
+		if len(args) == 2 {
+			articleID = args[1]
+		}
+		_, err = client.MarkAsRead(args[0], articleID)
+		return err
+	},
+}
+
+var rssRuleCmd = &cobra.Command{
+	Use:   "rule",
+	Short: "Manage auto-download rules",
+}
+
+var rssRuleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List auto-download rules",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		rules, err := client.GetAllRules()
+		if err != nil {
+			return err
+		}
+		return output.Write(cmd.OutOrStdout(), outputFormat(cmd), rules)
+	},
+}
+
+var rssRuleSetCmd = &cobra.Command{
+	Use:   "set <name>",
+	Short: "Create or update an auto-download rule",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		enabled, _ := cmd.Flags().GetBool("enabled")
+		mustContain, _ := cmd.Flags().GetString("must-contain")
+		mustNotContain, _ := cmd.Flags().GetString("must-not-contain")
+		useRegex, _ := cmd.Flags().GetBool("regex")
+		episodeFilter, _ := cmd.Flags().GetString("episode-filter")
+		smartFilter, _ := cmd.Flags().GetBool("smart-filter")
+		feeds, _ := cmd.Flags().GetStringSlice("feed")
+		addPaused, _ := cmd.Flags().GetBool("paused")
+		category, _ := cmd.Flags().GetString("category")
+		savePath, _ := cmd.Flags().GetString("save-path")
+		contentLayout, _ := cmd.Flags().GetString("content-layout")
+
+		rule := api.RSSRule{
+			Enabled:              enabled,
+			MustContain:          mustContain,
+			MustNotContain:       mustNotContain,
+			UseRegex:             useRegex,
+			EpisodeFilter:        episodeFilter,
+			SmartFilter:          smartFilter,
+			AffectedFeeds:        feeds,
+			AddPaused:            addPaused,
+			AssignedCategory:     category,
+			SavePath:             savePath,
+			TorrentContentLayout: contentLayout,
+		}
+		_, err = client.SetRule(args[0], rule)
+		return err
+	},
+}
+
+var rssRuleRenameCmd = &cobra.Command{
+	Use:   "rename <name> <new-name>",
+	Short: "Rename an auto-download rule",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.RenameRule(args[0], args[1])
+		return err
+	},
+}
+
+var rssRuleRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove an auto-download rule",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.RemoveRule(args[0])
+		return err
+	},
+}
+
+var rssRuleMatchesCmd = &cobra.Command{
+	Use:   "matches <name>",
+	Short: "List the articles a rule currently matches, grouped by feed URL",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		matches, err := client.GetMatchingArticles(args[0])
+		if err != nil {
+			return err
+		}
+		return output.Write(cmd.OutOrStdout(), outputFormat(cmd), matches)
+	},
+}
+
+func init() {
+	rssFeedListCmd.Flags().Bool("data", false, "include each feed's articles")
+
+	rssRuleSetCmd.Flags().Bool("enabled", true, "whether the rule is active")
+	rssRuleSetCmd.Flags().String("must-contain", "", "the rule matches only articles whose title contains this")
+	rssRuleSetCmd.Flags().String("must-not-contain", "", "the rule never matches articles whose title contains this")
+	rssRuleSetCmd.Flags().Bool("regex", false, "treat must-contain/must-not-contain as regular expressions instead of wildcards")
+	rssRuleSetCmd.Flags().String("episode-filter", "", "episode filter expression, e.g. \"1x01-1x99\"")
+	rssRuleSetCmd.Flags().Bool("smart-filter", false, "enable smart episode filtering")
+	rssRuleSetCmd.Flags().StringSlice("feed", nil, "feed URL this rule applies to, repeatable")
+	rssRuleSetCmd.Flags().Bool("paused", false, "add matched torrents in a paused state")
+	rssRuleSetCmd.Flags().String("category", "", "category assigned to matched torrents")
+	rssRuleSetCmd.Flags().String("save-path", "", "save path override for matched torrents")
+	rssRuleSetCmd.Flags().String("content-layout", "", "torrent content layout for matched torrents: Original, Subfolder or NoSubfolder")
+
+	rssFeedCmd.AddCommand(rssFeedListCmd, rssFeedAddCmd, rssFeedRemoveCmd, rssFeedMoveCmd, rssFeedRefreshCmd, rssFeedMarkReadCmd)
+	rssRuleCmd.AddCommand(rssRuleListCmd, rssRuleSetCmd, rssRuleRenameCmd, rssRuleRemoveCmd, rssRuleMatchesCmd)
+	rssCmd.AddCommand(rssFeedCmd, rssRuleCmd)
+	rootCmd.AddCommand(rssCmd)
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"github.com/6ftclaud/qbittorrent-go/cmd/qbt/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var tagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Manage tags",
+}
+
+var tagListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List available tags",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		tags, err := client.GetTags()
+		if err != nil {
+			return err
+		}
+		return output.Write(cmd.OutOrStdout(), outputFormat(cmd), tags)
+	},
+}
+
+var tagCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a tag",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.CreateTag(args[0])
+		return err
+	},
+}
+
+var tagDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a tag",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.DeleteTag(args[0])
+		return err
+	},
+}
+
+var tagAddCmd = &cobra.Command{
+	Use:   "add <name> <hash>...",
+	Short: "Add a tag to one or more torrents",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.SetTorrentTag(args[1:], args[0])
+		return err
+	},
+}
+
+var tagRemoveCmd = &cobra.Command{
+	Use:   "remove <name> <hash>...",
+	Short: "Remove a tag from one or more torrents",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.RemoveTorrentTag(args[1:], args[0])
+		return err
+	},
+}
+
+func init() {
+	tagCmd.AddCommand(tagListCmd, tagCreateCmd, tagDeleteCmd, tagAddCmd, tagRemoveCmd)
+	rootCmd.AddCommand(tagCmd)
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/6ftclaud/qbittorrent-go/cmd/qbt/internal/output"
+	api "github.com/6ftclaud/qbittorrent-go/modules"
+	"github.com/spf13/cobra"
+)
+
+var torrentCmd = &cobra.Command{
+	Use:   "torrent",
+	Short: "Manage torrents",
+}
+
+var torrentListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List torrents",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		filter, _ := cmd.Flags().GetString("filter")
+		category, _ := cmd.Flags().GetString("category")
+		tag, _ := cmd.Flags().GetString("tag")
+		sortBy, _ := cmd.Flags().GetString("sort")
+
+		torrents, err := client.GetTorrents(api.TorrentFilter{
+			Filter:   filter,
+			Category: category,
+			Tag:      tag,
+			Sort:     sortBy,
+		})
+		if err != nil {
+			return err
+		}
+		return output.Write(cmd.OutOrStdout(), outputFormat(cmd), torrents)
+	},
+}
+
+var torrentAddCmd = &cobra.Command{
+	Use:   "add <magnet-or-url>...",
+	Short: "Add one or more torrents by magnet link or URL",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		category, _ := cmd.Flags().GetString("category")
+		savePath, _ := cmd.Flags().GetString("save-path")
+		paused, _ := cmd.Flags().GetBool("paused")
+
+		_, err = client.AddTorrentURLs(args, api.AddTorrentOptions{
+			Category: category,
+			SavePath: savePath,
+			Paused:   paused,
+		})
+		return err
+	},
+}
+
+var torrentPauseCmd = &cobra.Command{
+	Use:   "pause <hash>...",
+	Short: "Pause one or more torrents",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.PauseMultiple(args)
+		return err
+	},
+}
+
+var torrentResumeCmd = &cobra.Command{
+	Use:   "resume <hash>...",
+	Short: "Resume one or more torrents",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.ResumeMultiple(args)
+		return err
+	},
+}
+
+var torrentDeleteCmd = &cobra.Command{
+	Use:   "delete <hash>...",
+	Short: "Delete one or more torrents",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		withFiles, _ := cmd.Flags().GetBool("with-files")
+		_, err = client.DeleteMultiple(args, strconv.FormatBool(withFiles))
+		return err
+	},
+}
+
+var torrentRecheckCmd = &cobra.Command{
+	Use:   "recheck <hash>...",
+	Short: "Force a recheck of one or more torrents",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.RecheckMultiple(args)
+		return err
+	},
+}
+
+var torrentReannounceCmd = &cobra.Command{
+	Use:   "reannounce <hash>...",
+	Short: "Reannounce one or more torrents to their trackers",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.ReannounceMultiple(args)
+		return err
+	},
+}
+
+func init() {
+	torrentListCmd.Flags().String("filter", "", "filter by status, e.g. downloading, seeding, paused")
+	torrentListCmd.Flags().String("category", "", "filter by category")
+	torrentListCmd.Flags().String("tag", "", "filter by tag")
+	torrentListCmd.Flags().String("sort", "", "sort field, e.g. name, size, ratio")
+
+	torrentAddCmd.Flags().String("category", "", "category to assign the added torrents")
+	torrentAddCmd.Flags().String("save-path", "", "save path for the added torrents")
+	torrentAddCmd.Flags().Bool("paused", false, "add the torrents in a paused state")
+
+	torrentDeleteCmd.Flags().Bool("with-files", false, "also delete the downloaded files")
+
+	torrentCmd.AddCommand(
+		torrentListCmd,
+		torrentAddCmd,
+		torrentPauseCmd,
+		torrentResumeCmd,
+		torrentDeleteCmd,
+		torrentRecheckCmd,
+		torrentReannounceCmd,
+	)
+	rootCmd.AddCommand(torrentCmd)
+}
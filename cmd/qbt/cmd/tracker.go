@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/6ftclaud/qbittorrent-go/cmd/qbt/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var trackerCmd = &cobra.Command{
+	Use:   "tracker",
+	Short: "Manage a torrent's trackers",
+}
+
+var trackerListCmd = &cobra.Command{
+	Use:   "list <hash>",
+	Short: "List a torrent's trackers",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		trackers, err := client.GetTrackers(args[0])
+		if err != nil {
+			return err
+		}
+		return output.Write(cmd.OutOrStdout(), outputFormat(cmd), trackers)
+	},
+}
+
+var trackerAddCmd = &cobra.Command{
+	Use:   "add <hash> <url>...",
+	Short: "Add one or more trackers to a torrent",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.AddTracker(map[string]string{
+			"hash": args[0],
+			"urls": strings.Join(args[1:], "|"),
+		})
+		return err
+	},
+}
+
+var trackerEditCmd = &cobra.Command{
+	Use:   "edit <hash> <old-url> <new-url>",
+	Short: "Change one of a torrent's tracker URLs",
+	Args:  cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.EditTracker(map[string]string{
+			"hash":    args[0],
+			"origUrl": args[1],
+			"newUrl":  args[2],
+		})
+		return err
+	},
+}
+
+var trackerRemoveCmd = &cobra.Command{
+	Use:   "remove <hash> <url>...",
+	Short: "Remove one or more trackers from a torrent",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.RemoveTrackers(map[string]string{
+			"hash": args[0],
+			"urls": strings.Join(args[1:], "|"),
+		})
+		return err
+	},
+}
+
+func init() {
+	trackerCmd.AddCommand(trackerListCmd, trackerAddCmd, trackerEditCmd, trackerRemoveCmd)
+	rootCmd.AddCommand(trackerCmd)
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	api "github.com/6ftclaud/qbittorrent-go/modules"
+	"github.com/6ftclaud/qbittorrent-go/watcher"
+	"github.com/spf13/cobra"
+)
+
+// cmdLogger adapts cmd's stderr into the api.Logger interface watcher.Config expects.
+type cmdLogger struct{ cmd *cobra.Command }
+
+func (l cmdLogger) Debug(args ...interface{}) { fmt.Fprintln(l.cmd.ErrOrStderr(), args...) }
+func (l cmdLogger) Info(args ...interface{})  { fmt.Fprintln(l.cmd.ErrOrStderr(), args...) }
+
+var watchCmd = &cobra.Command{
+	Use:   "watch <directory>...",
+	Short: "Watch directories for *.torrent/*.magnet files and submit them automatically",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		category, _ := cmd.Flags().GetString("category")
+		savePath, _ := cmd.Flags().GetString("save-path")
+		paused, _ := cmd.Flags().GetBool("paused")
+		ratioLimit, _ := cmd.Flags().GetFloat64("ratio-limit")
+		moveOnSuccess, _ := cmd.Flags().GetString("move-on-success")
+		moveOnFailure, _ := cmd.Flags().GetString("move-on-failure")
+		debounce, _ := cmd.Flags().GetDuration("debounce")
+
+		options := api.AddTorrentOptions{
+			Category:   category,
+			SavePath:   savePath,
+			Paused:     paused,
+			RatioLimit: ratioLimit,
+		}
+
+		dirs := make([]watcher.DirConfig, len(args))
+		for i, dir := range args {
+			dirs[i] = watcher.DirConfig{
+				Path:          dir,
+				Options:       options,
+				MoveOnSuccess: moveOnSuccess,
+				MoveOnFailure: moveOnFailure,
+			}
+		}
+
+		w := watcher.New(client, watcher.Config{
+			Dirs:           dirs,
+			DebounceWindow: debounce,
+			Logger:         cmdLogger{cmd: cmd},
+		})
+		return w.Run(cmd.Context())
+	},
+}
+
+func init() {
+	watchCmd.Flags().String("category", "", "category assigned to every torrent submitted from the watched directories")
+	watchCmd.Flags().String("save-path", "", "save path for every torrent submitted from the watched directories")
+	watchCmd.Flags().Bool("paused", false, "add submitted torrents in a paused state")
+	watchCmd.Flags().Float64("ratio-limit", 0, "share ratio limit applied to submitted torrents")
+	watchCmd.Flags().String("move-on-success", "", "directory to move a file to once qBittorrent accepts it")
+	watchCmd.Flags().String("move-on-failure", "", "directory to move a file to if submission fails")
+	watchCmd.Flags().Duration("debounce", 2*time.Second, "time to wait after the last filesystem event before submitting a file")
+
+	rootCmd.AddCommand(watchCmd)
+}
@@ -0,0 +1,87 @@
+/*
+Package output renders command results for qbt in one of the formats its
+-o/--output flag accepts: a tab-aligned table (the default), JSON or YAML.
+*/
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Write renders data to w in the given format. An empty format means table.
+func Write(w io.Writer, format string, data interface{}) error {
+	switch format {
+	case "", "table":
+		return writeTable(w, data)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(data)
+	case "yaml":
+		out, err := yaml.Marshal(data)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	default:
+		return fmt.Errorf("unknown output format %q, want table, json or yaml", format)
+	}
+}
+
+// writeTable renders a slice of structs as a tab-aligned table, using each
+// field's json tag as its column header. Anything else (maps, scalars, a
+// single struct) falls back to a plain fmt.Fprintln.
+func writeTable(w io.Writer, data interface{}) error {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice || v.Len() == 0 {
+		_, err := fmt.Fprintln(w, data)
+		return err
+	}
+
+	elem := indirect(v.Index(0))
+	if elem.Kind() != reflect.Struct {
+		for i := 0; i < v.Len(); i++ {
+			fmt.Fprintln(w, v.Index(i).Interface())
+		}
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(fieldHeaders(elem.Type()), "\t"))
+	for i := 0; i < v.Len(); i++ {
+		row := indirect(v.Index(i))
+		cells := make([]string, row.NumField())
+		for j := 0; j < row.NumField(); j++ {
+			cells[j] = fmt.Sprintf("%v", row.Field(j).Interface())
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	return v
+}
+
+func fieldHeaders(t reflect.Type) []string {
+	headers := make([]string, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			name = t.Field(i).Name
+		}
+		headers[i] = name
+	}
+	return headers
+}
@@ -0,0 +1,17 @@
+// Command qbt is a CLI for the qBittorrent WebUI API, built on the
+// qbittorrent-go client library.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/6ftclaud/qbittorrent-go/cmd/qbt/cmd"
+)
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Package exporter wraps a qbittorrent-go Client as a prometheus.Collector,
+scraping transfer/info and torrents/info on each collection and exposing
+per-torrent and session-wide gauges/counters that mirror the metrics
+existing community qBittorrent exporters already use, so dashboards built
+against those keep working.
+*/
+package exporter
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	api "github.com/6ftclaud/qbittorrent-go/modules"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	torrentDownloadBytes = prometheus.NewDesc(
+		"qbittorrent_torrent_download_bytes_total",
+		"Total bytes downloaded for a torrent.",
+		[]string{"hash", "name", "category", "tracker"}, nil,
+	)
+	torrentUploadBytes = prometheus.NewDesc(
+		"qbittorrent_torrent_upload_bytes_total",
+		"Total bytes uploaded for a torrent.",
+		[]string{"hash", "name", "category", "tracker"}, nil,
+	)
+	torrentPeers = prometheus.NewDesc(
+		"qbittorrent_torrent_peers",
+		"Number of seeds and leeches connected to a torrent.",
+		[]string{"hash", "name", "category", "tracker"}, nil,
+	)
+	torrentState = prometheus.NewDesc(
+		"qbittorrent_torrent_state",
+		"Always 1, labeled with the torrent's current state so it can be counted by state in PromQL.",
+		[]string{"hash", "name", "category", "tracker", "state"}, nil,
+	)
+	dhtNodes = prometheus.NewDesc(
+		"qbittorrent_dht_nodes",
+		"Number of DHT nodes the client is connected to.",
+		nil, nil,
+	)
+	sessionUploadedBytes = prometheus.NewDesc(
+		"qbittorrent_session_uploaded_bytes_total",
+		"Total bytes uploaded this session, across all torrents.",
+		nil, nil,
+	)
+	sessionDownloadedBytes = prometheus.NewDesc(
+		"qbittorrent_session_downloaded_bytes_total",
+		"Total bytes downloaded this session, across all torrents.",
+		nil, nil,
+	)
+	scrapeErrorsTotal = prometheus.NewDesc(
+		"qbittorrent_scrape_errors_total",
+		"Number of scrapes of the qBittorrent WebUI API that failed.",
+		nil, nil,
+	)
+)
+
+/*
+Options configures an Exporter.
+
+	ScrapeInterval	time.Duration	Minimum time between scrapes of the qBittorrent WebUI. A Collect within the interval reuses the previous scrape instead of hitting the WebUI again. Defaults to 15s
+	ScrapeTimeout	time.Duration	Per-scrape request timeout. Defaults to 10s
+	MaxTorrents	int	Maximum number of torrents to emit per-torrent metrics for, bounding label cardinality on instances with very large torrent counts. Zero means unlimited
+*/
+type Options struct {
+	ScrapeInterval time.Duration
+	ScrapeTimeout  time.Duration
+	MaxTorrents    int
+}
+
+/*
+DefaultOptions returns the Options an Exporter falls back to when a field is
+left at its zero value: a 15s scrape interval, a 10s scrape timeout and no
+cap on the number of torrents reported.
+*/
+func DefaultOptions() Options {
+	return Options{
+		ScrapeInterval: 15 * time.Second,
+		ScrapeTimeout:  10 * time.Second,
+	}
+}
+
+/*
+Exporter adapts a *api.Client into a prometheus.Collector. It caches the
+result of each scrape for Options.ScrapeInterval, so a Prometheus server
+configured with a shorter scrape_interval can't hammer the qBittorrent
+WebUI harder than that.
+*/
+type Exporter struct {
+	client  *api.Client
+	options Options
+
+	mu           sync.Mutex
+	lastScrape   time.Time
+	lastMetrics  []prometheus.Metric
+	scrapeErrors float64
+}
+
+// New creates an Exporter wrapping client. Zero-valued fields in opts fall
+// back to the values in DefaultOptions.
+func New(client *api.Client, opts Options) *Exporter {
+	defaults := DefaultOptions()
+	if opts.ScrapeInterval <= 0 {
+		opts.ScrapeInterval = defaults.ScrapeInterval
+	}
+	if opts.ScrapeTimeout <= 0 {
+		opts.ScrapeTimeout = defaults.ScrapeTimeout
+	}
+	return &Exporter{client: client, options: opts}
+}
+
+// Describe implements prometheus.Collector.
+func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	ch <- torrentDownloadBytes
+	ch <- torrentUploadBytes
+	ch <- torrentPeers
+	ch <- torrentState
+	ch <- dhtNodes
+	ch <- sessionUploadedBytes
+	ch <- sessionDownloadedBytes
+	ch <- scrapeErrorsTotal
+}
+
+// Collect implements prometheus.Collector, reusing the previous scrape's
+// metrics when it's younger than Options.ScrapeInterval or when the latest
+// scrape attempt failed.
+func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.lastMetrics == nil || time.Since(e.lastScrape) >= e.options.ScrapeInterval {
+		if metrics, err := e.scrape(); err != nil {
+			e.scrapeErrors++
+		} else {
+			e.lastMetrics = metrics
+			e.lastScrape = time.Now()
+		}
+	}
+
+	for _, m := range e.lastMetrics {
+		ch <- m
+	}
+	ch <- prometheus.MustNewConstMetric(scrapeErrorsTotal, prometheus.CounterValue, e.scrapeErrors)
+}
+
+// scrape queries transfer/info and torrents/info and builds the metric set
+// for a single Collect cycle.
+func (e *Exporter) scrape() ([]prometheus.Metric, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.options.ScrapeTimeout)
+	defer cancel()
+
+	info, err := e.client.GetTransferInfoContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	torrents, err := e.client.GetTorrentListContext(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.options.MaxTorrents > 0 && len(torrents) > e.options.MaxTorrents {
+		torrents = torrents[:e.options.MaxTorrents]
+	}
+
+	metrics := make([]prometheus.Metric, 0, len(torrents)*4+3)
+	metrics = append(metrics,
+		prometheus.MustNewConstMetric(dhtNodes, prometheus.GaugeValue, float64(info.DhtNodes)),
+		prometheus.MustNewConstMetric(sessionUploadedBytes, prometheus.CounterValue, float64(info.UpInfoData)),
+		prometheus.MustNewConstMetric(sessionDownloadedBytes, prometheus.CounterValue, float64(info.DlInfoData)),
+	)
+
+	for _, t := range torrents {
+		tracker := trackerHost(t.Tracker)
+		metrics = append(metrics,
+			prometheus.MustNewConstMetric(torrentDownloadBytes, prometheus.CounterValue, float64(t.Downloaded), t.Hash, t.Name, t.Category, tracker),
+			prometheus.MustNewConstMetric(torrentUploadBytes, prometheus.CounterValue, float64(t.Uploaded), t.Hash, t.Name, t.Category, tracker),
+			prometheus.MustNewConstMetric(torrentPeers, prometheus.GaugeValue, float64(t.NumSeeds+t.NumLeechs), t.Hash, t.Name, t.Category, tracker),
+			prometheus.MustNewConstMetric(torrentState, prometheus.GaugeValue, 1, t.Hash, t.Name, t.Category, tracker, t.State),
+		)
+	}
+	return metrics, nil
+}
+
+// trackerHost extracts the host from a torrent's primary tracker URL so
+// dashboards can group by tracker without the full announce path in the
+// label. Falls back to the raw value for torrents with no tracker yet.
+func trackerHost(tracker string) string {
+	if tracker == "" {
+		return ""
+	}
+	u, err := url.Parse(tracker)
+	if err != nil || u.Host == "" {
+		return tracker
+	}
+	return u.Host
+}
+
+/*
+ListenAndServe registers e with a fresh prometheus.Registry, mounts it at
+/metrics via promhttp, and blocks serving HTTP on addr.
+*/
+func (e *Exporter) ListenAndServe(addr string) error {
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(e); err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	return http.ListenAndServe(addr, mux)
+}
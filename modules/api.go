@@ -6,13 +6,21 @@ An attempt to implement the full qBittorrent Web API in Golang
 package api
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
 	"io"
+	"math/rand"
+	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -27,6 +35,16 @@ func init() {
 	log.SetLevel(log.DebugLevel)
 }
 
+/*
+Logger is the interface the client uses to report request activity. A
+*logrus.Logger (the package default) satisfies it, but any implementation
+can be injected via Settings.Logger.
+*/
+type Logger interface {
+	Debug(args ...interface{})
+	Info(args ...interface{})
+}
+
 /*
 Set the Client struct
 */
@@ -34,12 +52,72 @@ type Client struct {
 	http          *http.Client
 	URL           string
 	Authenticated bool
-}
 
-/*
-NewClient creates a new client connection to qBittorrent
-*/
-func NewClient(host string) *Client {
+	username     string
+	password     string
+	basicUser    string
+	basicPass    string
+	autoReauth   bool
+	logger       Logger
+	maxRetries   int
+	retryBackoff time.Duration
+}
+
+/*
+Settings configures a Client built with NewClientWithSettings.
+
+	Host	string	Hostname or base URL of the qBittorrent WebUI, i.e. localhost or https://localhost
+	Port	int	Port of the WebUI. Leave at 0 if Host already includes it
+	Username	string	Username used for Login
+	Password	string	Password used for Login
+	TLS	bool	Connect over https
+	TLSSkipVerify	bool	Skip TLS certificate verification
+	BasicUser	string	Username for an HTTP basic-auth reverse proxy in front of qBittorrent
+	BasicPass	string	Password for an HTTP basic-auth reverse proxy in front of qBittorrent
+	Timeout	time.Duration	Timeout applied to every request. Zero means no timeout
+	Transport	http.RoundTripper	Custom transport. Overrides TLSSkipVerify when set; TLS still selects the https scheme
+	Logger	Logger	Logger used for request activity. Defaults to the package's logrus logger
+	AutoReauth	bool	Transparently re-run Login and retry once when a JSON-decoding getter's request comes back 403 Forbidden. Methods that return a raw *http.Response or a string are not retried
+	MaxRetries	int	Number of times to retry a GET/POST request that fails transiently (connection errors, 502/503/504). Zero means no retries. Multipart requests (the AddTorrent* methods) are sent once
+	RetryBackoff	time.Duration	Base delay for the exponential backoff between retries, with jitter applied. Defaults to 500ms
+*/
+type Settings struct {
+	Host          string
+	Port          int
+	Username      string
+	Password      string
+	TLS           bool
+	TLSSkipVerify bool
+	BasicUser     string
+	BasicPass     string
+	Timeout       time.Duration
+	Transport     http.RoundTripper
+	Logger        Logger
+	AutoReauth    bool
+	MaxRetries    int
+	RetryBackoff  time.Duration
+}
+
+/*
+NewClientWithSettings creates a new client connection to qBittorrent from a
+Settings struct, giving control over TLS, timeouts, basic-auth and logging
+that the bare-host NewClient cannot express. The underlying *http.Client is
+built with its cookiejar.Jar already in place, so Login never has to
+replace it.
+*/
+func NewClientWithSettings(settings Settings) *Client {
+	host := settings.Host
+	if settings.Port != 0 {
+		host = strings.TrimRight(host, "/") + ":" + strconv.Itoa(settings.Port)
+	}
+	if !strings.Contains(host, "://") {
+		scheme := "http"
+		if settings.TLS {
+			scheme = "https"
+		}
+		host = scheme + "://" + host
+	}
+
 	// ensure url ends with a slash
 	if host[len(host)-1:] != "/" {
 		host += "/"
@@ -47,67 +125,333 @@ func NewClient(host string) *Client {
 
 	// Add the API url
 	host += "api/v2/"
-	client := &http.Client{}
+
+	jar, _ := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+
+	transport := settings.Transport
+	if transport == nil && settings.TLSSkipVerify {
+		transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+
+	logger := settings.Logger
+	if logger == nil {
+		logger = log.StandardLogger()
+	}
 
 	return &Client{
-		http:          client,
+		http: &http.Client{
+			Jar:       jar,
+			Transport: transport,
+			Timeout:   settings.Timeout,
+		},
 		URL:           host,
 		Authenticated: false,
+		username:      settings.Username,
+		password:      settings.Password,
+		basicUser:     settings.BasicUser,
+		basicPass:     settings.BasicPass,
+		autoReauth:    settings.AutoReauth,
+		logger:        logger,
+		maxRetries:    settings.MaxRetries,
+		retryBackoff:  settings.RetryBackoff,
 	}
 }
 
+/*
+NewClient creates a new client connection to qBittorrent. It is a thin
+wrapper around NewClientWithSettings for callers that only need to point
+at a host.
+*/
+func NewClient(host string) *Client {
+	return NewClientWithSettings(Settings{Host: host})
+}
+
 /*
 Perform a GET request
 
+	ctx	context.Context	Cancels the request
 	endpoint	string	Set the endpoint path, i.e. torrents/info
 	opts	map[string]string	optional parameters (?username=usr&password=pswrd)
 */
-func (client *Client) get(endpoint string, opts map[string]string) (*http.Response, error) {
-	req, err := http.NewRequest("GET", client.URL+endpoint, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to build the request")
-	}
+func (client *Client) get(ctx context.Context, endpoint string, opts map[string]string) (*http.Response, error) {
+	return client.doRequest(ctx, func(ctx context.Context) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", client.URL+endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
 
-	req.Header.Set("Referer", client.URL)
+		req.Header.Set("Referer", client.URL)
+		client.setBasicAuth(req)
 
-	// add optional parameters
-	if opts != nil {
-		query := req.URL.Query()
-		for k, v := range opts {
-			query.Add(k, v)
+		// add optional parameters
+		if opts != nil {
+			query := req.URL.Query()
+			for k, v := range opts {
+				query.Add(k, v)
+			}
+			req.URL.RawQuery = query.Encode()
 		}
-		req.URL.RawQuery = query.Encode()
-	}
 
-	resp, err := client.http.Do(req)
-	log.Debug("Sending GET request to ", req.URL)
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to execute the request")
-	}
-	return resp, nil
+		client.logger.Debug("Sending GET request to ", req.URL)
+		return req, nil
+	})
 }
 
 /*
 Perform a POST request
 
+	ctx	context.Context	Cancels the request
 	endpoint	string	Set the endpoint, i.e. app/shutdown
 	opts map[string]string	optional post data
 */
-func (client *Client) post(endpoint string, opts map[string]string) (*http.Response, error) {
+func (client *Client) post(ctx context.Context, endpoint string, opts map[string]string) (*http.Response, error) {
 	// add optional parameters
 	params := url.Values{}
 	for k, v := range opts {
 		params.Add(k, v)
 	}
-	req, err := http.NewRequest("POST", client.URL+endpoint, strings.NewReader(params.Encode()))
+	return client.doRequest(ctx, func(ctx context.Context) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "POST", client.URL+endpoint, strings.NewReader(params.Encode()))
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Add("Referer", client.URL)
+		req.Header.Add("content-type", "application/x-www-form-urlencoded")
+		client.setBasicAuth(req)
+
+		client.logger.Debug("Sending POST request to ", req.URL, " with args ", params)
+		return req, nil
+	})
+}
+
+// transientRetryable reports whether a get/post attempt failed in a way
+// that's worth retrying: a transport-level error (refused/reset connection,
+// timeout) or one of the 502/503/504 status codes a reverse proxy or a
+// qBittorrent instance under load commonly returns.
+func transientRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryBackoff returns the exponential-backoff-with-jitter delay before the
+// given retry attempt (0-indexed), capped at 10 times the base delay.
+func retryBackoff(attempt int, base time.Duration) time.Duration {
+	d := base << uint(attempt)
+	if max := base * 10; d > max {
+		d = max
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d)/2+1))
+}
+
+/*
+doRequest builds and sends a request via newRequest, retrying transient
+failures (connection errors, 502/503/504) up to Settings.MaxRetries times
+with exponential backoff and jitter between attempts. newRequest is called
+again on every attempt since an *http.Request can't be reused once sent.
+*/
+func (client *Client) doRequest(ctx context.Context, newRequest func(ctx context.Context) (*http.Request, error)) (*http.Response, error) {
+	backoff := client.retryBackoff
+	if backoff <= 0 {
+		backoff = 500 * time.Millisecond
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		var req *http.Request
+		req, err = newRequest(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to build the request")
+		}
+
+		resp, err = client.http.Do(req)
+		if attempt >= client.maxRetries || !transientRetryable(resp, err) {
+			break
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryBackoff(attempt, backoff)):
+		}
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to execute the request")
+	}
+	return resp, nil
+}
+
+// setBasicAuth attaches the configured reverse-proxy basic-auth credentials, if any.
+func (client *Client) setBasicAuth(req *http.Request) {
+	if client.basicUser != "" {
+		req.SetBasicAuth(client.basicUser, client.basicPass)
+	}
+}
+
+// Sentinel errors returned by do() based on the response status code.
+var (
+	ErrUnauthorized = errors.New("qbittorrent: not logged in or session expired")
+	ErrNotFound     = errors.New("qbittorrent: not found")
+	ErrConflict     = errors.New("qbittorrent: conflicting operation")
+)
+
+// statusError maps a response status code to one of the sentinel errors above.
+func statusError(code int) error {
+	switch code {
+	case http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	default:
+		return nil
+	}
+}
+
+/*
+do runs request, decodes the response into out and translates non-2xx
+statuses into the sentinel errors above. It is the shared tail end of every
+typed getter, replacing the hand-parsed io.ReadAll/json.Unmarshal pairs that
+used to silently discard errors. A nil out skips decoding, for endpoints
+whose body is not worth reading.
+
+If the response comes back 403 and the client is both Authenticated and
+configured with Settings.AutoReauth, do transparently re-runs Login with the
+cached credentials and retries request exactly once before giving up.
+*/
+func (client *Client) do(ctx context.Context, request func(ctx context.Context) (*http.Response, error), out interface{}) error {
+	resp, err := request(ctx)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusForbidden && client.autoReauth && client.Authenticated {
+		resp.Body.Close()
+		if _, err := client.LoginContext(ctx, client.username, client.password); err != nil {
+			return err
+		}
+		resp, err = request(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	defer resp.Body.Close()
+
+	if err := statusError(resp.StatusCode); err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+		return errors.Wrap(err, "Failed to decode the response body")
+	}
+	return nil
+}
+
+/*
+Perform a multipart/form-data POST request
+
+	ctx	context.Context	Cancels the request
+	endpoint	string	Set the endpoint, i.e. torrents/add
+	opts	map[string]string	optional form fields
+	fileField	string	name of the file form field, empty if none
+	fileName	string	name reported for the uploaded file
+	file	io.Reader	file contents, nil if none
+*/
+func (client *Client) postMultipart(ctx context.Context, endpoint string, opts map[string]string, fileField string, fileName string, file io.Reader) (*http.Response, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if file != nil {
+		part, err := writer.CreateFormFile(fileField, fileName)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to create the multipart file field")
+		}
+		if _, err := io.Copy(part, file); err != nil {
+			return nil, errors.Wrap(err, "Failed to copy the file into the multipart request")
+		}
+	}
+
+	for k, v := range opts {
+		if err := writer.WriteField(k, v); err != nil {
+			return nil, errors.Wrap(err, "Failed to write a multipart form field")
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "Failed to close the multipart writer")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", client.URL+endpoint, body)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to build the request")
 	}
 
 	req.Header.Add("Referer", client.URL)
-	req.Header.Add("content-type", "application/x-www-form-urlencoded")
+	req.Header.Add("content-type", writer.FormDataContentType())
+	client.setBasicAuth(req)
 
-	log.Debug("Sending POST request to ", req.URL, " with args ", params)
+	client.logger.Debug("Sending multipart POST request to ", req.URL, " with args ", opts)
+	resp, err := client.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to execute the request")
+	}
+	return resp, nil
+}
+
+// postMultipartFiles is the postMultipart sibling for submitting several
+// "torrents" file parts in a single request, keyed by filename.
+func (client *Client) postMultipartFiles(ctx context.Context, endpoint string, opts map[string]string, files map[string]io.Reader) (*http.Response, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for name, file := range files {
+		part, err := writer.CreateFormFile("torrents", name)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to create the multipart file field")
+		}
+		if _, err := io.Copy(part, file); err != nil {
+			return nil, errors.Wrap(err, "Failed to copy the file into the multipart request")
+		}
+	}
+
+	for k, v := range opts {
+		if err := writer.WriteField(k, v); err != nil {
+			return nil, errors.Wrap(err, "Failed to write a multipart form field")
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "Failed to close the multipart writer")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", client.URL+endpoint, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to build the request")
+	}
+
+	req.Header.Add("Referer", client.URL)
+	req.Header.Add("content-type", writer.FormDataContentType())
+	client.setBasicAuth(req)
+
+	client.logger.Debug("Sending multipart POST request to ", req.URL, " with args ", opts)
 	resp, err := client.http.Do(req)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to execute the request")
@@ -131,11 +475,16 @@ func (Client) processList(key string, list []string) (hashMap map[string]string)
 
 // Log in to qBittorrent, obtain a cookie for later use and set auth status to True
 func (client *Client) Login(username string, password string) (bool, error) {
+	return client.LoginContext(context.Background(), username, password)
+}
+
+// LoginContext is the context-aware variant of Login.
+func (client *Client) LoginContext(ctx context.Context, username string, password string) (bool, error) {
 	credentials := make(map[string]string)
 	credentials["username"] = username
 	credentials["password"] = password
 
-	resp, err := client.post("auth/login", credentials)
+	resp, err := client.post(ctx, "auth/login", credentials)
 	if err != nil {
 		return false, err
 	} else if resp.Status != "200 OK" {
@@ -144,16 +493,17 @@ func (client *Client) Login(username string, password string) (bool, error) {
 		return false, errors.Wrap(err, "No cookies in login response")
 	}
 
-	jar, _ := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
 	cookieURL, _ := url.Parse(client.URL)
+	client.http.Jar.SetCookies(cookieURL, []*http.Cookie{resp.Cookies()[0]})
 
-	jar.SetCookies(cookieURL, []*http.Cookie{resp.Cookies()[0]})
-	client.http.Jar = jar
+	// cache the credentials for later re-authentication
+	client.username = username
+	client.password = password
 
 	// change authentication status so we know were authenticated in later requests
 	client.Authenticated = true
 
-	log.Info("Logged in successfully.")
+	client.logger.Info("Logged in successfully.")
 	return true, nil
 }
 
@@ -161,52 +511,129 @@ func (client *Client) Login(username string, password string) (bool, error) {
 Logs you out of the client
 */
 func (client *Client) Logout() (*http.Response, error) {
-	return client.get("auth/logout", nil)
+	return client.LogoutContext(context.Background())
+}
+
+// LogoutContext is the context-aware variant of Logout.
+func (client *Client) LogoutContext(ctx context.Context) (*http.Response, error) {
+	return client.get(ctx, "auth/logout", nil)
+}
+
+/*
+Ping checks whether the session is still valid by querying app/version. It
+returns ErrUnauthorized if the qBittorrent cookie has expired or was never
+established, mirroring the "authorized" checks other qBittorrent clients run
+before issuing a batch of requests.
+*/
+func (client *Client) Ping(ctx context.Context) error {
+	_, err := client.GetApplicationVersionContext(ctx)
+	return err
 }
 
 /*
 Queries the client for the current application version
 */
 func (client *Client) GetApplicationVersion() (string, error) {
-	resp, err := client.get("app/version", nil)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), err
+	return client.GetApplicationVersionContext(context.Background())
+}
+
+// GetApplicationVersionContext is the context-aware variant of GetApplicationVersion.
+func (client *Client) GetApplicationVersionContext(ctx context.Context) (string, error) {
+	resp, err := client.get(ctx, "app/version", nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err := statusError(resp.StatusCode); err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to read the response body")
+	}
+	return string(data), nil
 }
 
 /*
 Queries the client for the current Web API version
 */
 func (client *Client) GetVersion() (string, error) {
-	resp, err := client.get("app/webapiVersion", nil)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), err
+	return client.GetVersionContext(context.Background())
+}
+
+// GetVersionContext is the context-aware variant of GetVersion.
+func (client *Client) GetVersionContext(ctx context.Context) (string, error) {
+	resp, err := client.get(ctx, "app/webapiVersion", nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err := statusError(resp.StatusCode); err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to read the response body")
+	}
+	return string(data), nil
 }
 
 /*
 Gets the build info
 */
 func (client *Client) GetBuildInfo() (string, error) {
-	resp, err := client.get("app/buildInfo", nil)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), err
+	return client.GetBuildInfoContext(context.Background())
+}
+
+// GetBuildInfoContext is the context-aware variant of GetBuildInfo.
+func (client *Client) GetBuildInfoContext(ctx context.Context) (string, error) {
+	resp, err := client.get(ctx, "app/buildInfo", nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err := statusError(resp.StatusCode); err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to read the response body")
+	}
+	return string(data), nil
 }
 
 /*
 Shuts down the client
 */
 func (client *Client) Shutdown() (*http.Response, error) {
-	return client.post("app/shutdown", nil)
+	return client.ShutdownContext(context.Background())
+}
+
+// ShutdownContext is the context-aware variant of Shutdown.
+func (client *Client) ShutdownContext(ctx context.Context) (*http.Response, error) {
+	return client.post(ctx, "app/shutdown", nil)
 }
 
 /*
 Gets current client preferences
 */
-func (client *Client) GetPreferences() (map[string]interface{}, error) {
-	resp, err := client.get("app/preferences", nil)
-	byteValue, _ := io.ReadAll(resp.Body)
-	var data map[string]interface{}
-	json.Unmarshal([]byte(byteValue), &data)
-	return data, err
+func (client *Client) GetPreferences() (Preferences, error) {
+	return client.GetPreferencesContext(context.Background())
+}
+
+// GetPreferencesContext is the context-aware variant of GetPreferences.
+func (client *Client) GetPreferencesContext(ctx context.Context) (Preferences, error) {
+	var prefs Preferences
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "app/preferences", nil)
+	}
+	return prefs, client.do(ctx, request, &prefs)
 }
 
 /*
@@ -216,20 +643,42 @@ Sets a preference
 	value	string	The setting value
 */
 func (client *Client) SetPreferences(token string, value string) (*http.Response, error) {
+	return client.SetPreferencesContext(context.Background(), token, value)
+}
+
+// SetPreferencesContext is the context-aware variant of SetPreferences.
+func (client *Client) SetPreferencesContext(ctx context.Context, token string, value string) (*http.Response, error) {
 	params := map[string]string{
 		"token": token,
 		"value": value,
 	}
-	return client.post("app/setPreferences", params)
+	return client.post(ctx, "app/setPreferences", params)
 }
 
 /*
 Gets the default save path
 */
 func (client *Client) GetDefaultSavePath() (string, error) {
-	resp, err := client.get("app/defaultSavePath", nil)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), err
+	return client.GetDefaultSavePathContext(context.Background())
+}
+
+// GetDefaultSavePathContext is the context-aware variant of GetDefaultSavePath.
+func (client *Client) GetDefaultSavePathContext(ctx context.Context) (string, error) {
+	resp, err := client.get(ctx, "app/defaultSavePath", nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err := statusError(resp.StatusCode); err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to read the response body")
+	}
+	return string(data), nil
 }
 
 /*
@@ -237,13 +686,161 @@ Gets the main data
 
 	rid int Response ID. Default is 0
 */
-func (client *Client) GetMainData(rid string) (string, error) {
+func (client *Client) GetMainData(rid string) (MainData, error) {
+	return client.GetMainDataContext(context.Background(), rid)
+}
+
+// GetMainDataContext is the context-aware variant of GetMainData.
+func (client *Client) GetMainDataContext(ctx context.Context, rid string) (MainData, error) {
 	params := map[string]string{
 		"rid": rid,
 	}
-	resp, err := client.get("sync/maindata", params)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), err
+	var data MainData
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "sync/maindata", params)
+	}
+	return data, client.do(ctx, request, &data)
+}
+
+/*
+MainDataEvent is emitted by SubscribeMainData. It carries the torrents/categories
+added, updated or removed since the previous event, plus the latest ServerState.
+*/
+type MainDataEvent struct {
+	Rid               int64
+	TorrentsAdded     map[string]BasicTorrent
+	TorrentsUpdated   map[string]BasicTorrent
+	TorrentsRemoved   []string
+	CategoriesAdded   map[string]Category
+	CategoriesRemoved []string
+	ServerState       ServerState
+}
+
+/*
+SubscribeMainData long-polls sync/maindata on the given interval, merges each
+partial update into a locally cached MainData snapshot and emits a diffed
+MainDataEvent for every poll. The returned channel is closed, and the
+goroutine driving it exits, once ctx is cancelled or a request fails.
+*/
+func (client *Client) SubscribeMainData(ctx context.Context, interval time.Duration) (<-chan MainDataEvent, error) {
+	snapshot, err := client.GetMainDataContext(ctx, "0")
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Torrents == nil {
+		snapshot.Torrents = map[string]BasicTorrent{}
+	}
+	if snapshot.Categories == nil {
+		snapshot.Categories = map[string]Category{}
+	}
+
+	events := make(chan MainDataEvent)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			delta, err := client.GetMainDataContext(ctx, strconv.FormatInt(snapshot.Rid, 10))
+			if err != nil {
+				client.logger.Debug("Stopping SubscribeMainData after a sync/maindata error: ", err)
+				return
+			}
+
+			event := MainDataEvent{
+				Rid:               delta.Rid,
+				TorrentsAdded:     map[string]BasicTorrent{},
+				TorrentsUpdated:   map[string]BasicTorrent{},
+				TorrentsRemoved:   delta.TorrentsRemoved,
+				CategoriesAdded:   map[string]Category{},
+				CategoriesRemoved: delta.CategoriesRemoved,
+			}
+
+			for hash, torrent := range delta.Torrents {
+				if _, exists := snapshot.Torrents[hash]; exists {
+					event.TorrentsUpdated[hash] = torrent
+				} else {
+					event.TorrentsAdded[hash] = torrent
+				}
+				snapshot.Torrents[hash] = torrent
+			}
+			for _, hash := range delta.TorrentsRemoved {
+				delete(snapshot.Torrents, hash)
+			}
+
+			for name, category := range delta.Categories {
+				event.CategoriesAdded[name] = category
+				snapshot.Categories[name] = category
+			}
+			for _, name := range delta.CategoriesRemoved {
+				delete(snapshot.Categories, name)
+			}
+
+			if delta.FullUpdate {
+				snapshot.ServerState = delta.ServerState
+			} else {
+				mergeServerState(&snapshot.ServerState, delta.ServerState)
+			}
+			event.ServerState = snapshot.ServerState
+			snapshot.Rid = delta.Rid
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// mergeServerState overlays onto state the fields delta's JSON object
+// actually carried, since qBittorrent's sync API only sends the
+// server_state keys that changed; a key's absence must leave state
+// untouched even when the corresponding Go field decodes to its zero value.
+func mergeServerState(state *ServerState, delta ServerState) {
+	if delta.present["connection_status"] {
+		state.ConnectionStatus = delta.ConnectionStatus
+	}
+	if delta.present["dht_nodes"] {
+		state.DhtNodes = delta.DhtNodes
+	}
+	if delta.present["dl_info_data"] {
+		state.DlInfoData = delta.DlInfoData
+	}
+	if delta.present["dl_info_speed"] {
+		state.DlInfoSpeed = delta.DlInfoSpeed
+	}
+	if delta.present["dl_rate_limit"] {
+		state.DlRateLimit = delta.DlRateLimit
+	}
+	if delta.present["up_info_data"] {
+		state.UpInfoData = delta.UpInfoData
+	}
+	if delta.present["up_info_speed"] {
+		state.UpInfoSpeed = delta.UpInfoSpeed
+	}
+	if delta.present["up_rate_limit"] {
+		state.UpRateLimit = delta.UpRateLimit
+	}
+	if delta.present["refresh_interval"] {
+		state.RefreshInterval = delta.RefreshInterval
+	}
+	if delta.present["free_space_on_disk"] {
+		state.FreeSpaceOnDisk = delta.FreeSpaceOnDisk
+	}
+	if delta.present["queueing"] {
+		state.Queueing = delta.Queueing
+	}
 }
 
 /*
@@ -253,21 +850,45 @@ Gets torrent peers
 	rid	string	Response Id
 */
 func (client *Client) GetTorrentPeers(hash string, rid string) (string, error) {
+	return client.GetTorrentPeersContext(context.Background(), hash, rid)
+}
+
+// GetTorrentPeersContext is the context-aware variant of GetTorrentPeers.
+func (client *Client) GetTorrentPeersContext(ctx context.Context, hash string, rid string) (string, error) {
 	params := make(map[string]string)
 	params["hash"] = hash
 	params["rid"] = rid
-	resp, err := client.get("sync/torrentPeers", params)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), err
+	resp, err := client.get(ctx, "sync/torrentPeers", params)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err := statusError(resp.StatusCode); err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to read the response body")
+	}
+	return string(data), nil
 }
 
 /*
 Get transfer info
 */
-func (client *Client) GetTransferInfo() (string, error) {
-	resp, err := client.get("transfer/info", nil)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), err
+func (client *Client) GetTransferInfo() (TransferInfo, error) {
+	return client.GetTransferInfoContext(context.Background())
+}
+
+// GetTransferInfoContext is the context-aware variant of GetTransferInfo.
+func (client *Client) GetTransferInfoContext(ctx context.Context) (TransferInfo, error) {
+	var info TransferInfo
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "transfer/info", nil)
+	}
+	return info, client.do(ctx, request, &info)
 }
 
 /*
@@ -277,27 +898,78 @@ Check if alternative speeds are enabled
 	1 means yes
 */
 func (client *Client) GetSpeedLimitsMode() (string, error) {
-	resp, err := client.get("transfer/speedLimitsMode", nil)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), err
+	return client.GetSpeedLimitsModeContext(context.Background())
+}
+
+// GetSpeedLimitsModeContext is the context-aware variant of GetSpeedLimitsMode.
+func (client *Client) GetSpeedLimitsModeContext(ctx context.Context) (string, error) {
+	resp, err := client.get(ctx, "transfer/speedLimitsMode", nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err := statusError(resp.StatusCode); err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to read the response body")
+	}
+	return string(data), nil
 }
 
 /*
 Gets the download speed limit value
 */
 func (client *Client) GetDownloadLimit() (string, error) {
-	resp, err := client.get("transfer/downloadLimit", nil)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), err
+	return client.GetDownloadLimitContext(context.Background())
+}
+
+// GetDownloadLimitContext is the context-aware variant of GetDownloadLimit.
+func (client *Client) GetDownloadLimitContext(ctx context.Context) (string, error) {
+	resp, err := client.get(ctx, "transfer/downloadLimit", nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err := statusError(resp.StatusCode); err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to read the response body")
+	}
+	return string(data), nil
 }
 
 /*
 Gets the upload speed limit value
 */
 func (client *Client) GetUploadLimit() (string, error) {
-	resp, err := client.get("transfer/uploadLimit", nil)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), err
+	return client.GetUploadLimitContext(context.Background())
+}
+
+// GetUploadLimitContext is the context-aware variant of GetUploadLimit.
+func (client *Client) GetUploadLimitContext(ctx context.Context) (string, error) {
+	resp, err := client.get(ctx, "transfer/uploadLimit", nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if err := statusError(resp.StatusCode); err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to read the response body")
+	}
+	return string(data), nil
 }
 
 /*
@@ -306,8 +978,204 @@ Ban peers
 	peers	[]string	enter host:port values into an array
 */
 func (client *Client) BanPeers(peers []string) (*http.Response, error) {
+	return client.BanPeersContext(context.Background(), peers)
+}
+
+// BanPeersContext is the context-aware variant of BanPeers.
+func (client *Client) BanPeersContext(ctx context.Context, peers []string) (*http.Response, error) {
 	params := client.processList("peers", peers)
-	return client.post("transfer/uploadLimit", params)
+	return client.post(ctx, "transfer/banPeers", params)
+}
+
+/*
+Add a torrent from a .torrent file on disk
+
+	path	string	Path to the .torrent file
+	opts	map[string]string	optional parameters: savepath, category, tags, paused, skip_checking, root_folder, rename, upLimit, dlLimit, autoTMM, sequentialDownload, firstLastPiecePrio
+*/
+func (client *Client) AddTorrentFromFile(path string, opts map[string]string) (*http.Response, error) {
+	return client.AddTorrentFromFileContext(context.Background(), path, opts)
+}
+
+// AddTorrentFromFileContext is the context-aware variant of AddTorrentFromFile.
+func (client *Client) AddTorrentFromFileContext(ctx context.Context, path string, opts map[string]string) (*http.Response, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to open the torrent file")
+	}
+	defer file.Close()
+
+	return client.AddTorrentFromReaderContext(ctx, filepath.Base(path), file, opts)
+}
+
+/*
+Add a torrent from an io.Reader, i.e. an already-opened .torrent file
+
+	name	string	Name reported for the uploaded file, i.e. my.torrent
+	r	io.Reader	Torrent file contents
+	opts	map[string]string	optional parameters: savepath, category, tags, paused, skip_checking, root_folder, rename, upLimit, dlLimit, autoTMM, sequentialDownload, firstLastPiecePrio
+*/
+func (client *Client) AddTorrentFromReader(name string, r io.Reader, opts map[string]string) (*http.Response, error) {
+	return client.AddTorrentFromReaderContext(context.Background(), name, r, opts)
+}
+
+// AddTorrentFromReaderContext is the context-aware variant of AddTorrentFromReader.
+func (client *Client) AddTorrentFromReaderContext(ctx context.Context, name string, r io.Reader, opts map[string]string) (*http.Response, error) {
+	return client.postMultipart(ctx, "torrents/add", opts, "torrents", name, r)
+}
+
+/*
+Add a torrent from a URL or magnet link
+
+	url	string	URL or magnet link to the torrent
+	opts	map[string]string	optional parameters: savepath, category, tags, paused, skip_checking, root_folder, rename, upLimit, dlLimit, autoTMM, sequentialDownload, firstLastPiecePrio
+*/
+func (client *Client) AddTorrentFromURL(url string, opts map[string]string) (*http.Response, error) {
+	return client.AddTorrentFromURLContext(context.Background(), url, opts)
+}
+
+// AddTorrentFromURLContext is the context-aware variant of AddTorrentFromURL.
+func (client *Client) AddTorrentFromURLContext(ctx context.Context, url string, opts map[string]string) (*http.Response, error) {
+	params := map[string]string{}
+	for k, v := range opts {
+		params[k] = v
+	}
+	params["urls"] = url
+
+	return client.postMultipart(ctx, "torrents/add", params, "", "", nil)
+}
+
+// AddTorrentOptions is the typed equivalent of the opts map accepted by
+// AddTorrentFromFile/AddTorrentFromReader/AddTorrentFromURL, covering the
+// full set of parameters torrents/add accepts.
+type AddTorrentOptions struct {
+	SavePath           string
+	Category           string
+	Tags               string
+	RootFolder         string
+	Rename             string
+	ContentLayout      string
+	Paused             bool
+	SkipChecking       bool
+	AutoTMM            bool
+	SequentialDownload bool
+	FirstLastPiecePrio bool
+	UpLimit            int64
+	DlLimit            int64
+	RatioLimit         float64
+	SeedingTimeLimit   int64
+}
+
+// params converts the options into the map[string]string shape
+// AddTorrentFromReaderContext/AddTorrentFromURLContext expect.
+func (o AddTorrentOptions) params() map[string]string {
+	params := map[string]string{}
+	if o.SavePath != "" {
+		params["savepath"] = o.SavePath
+	}
+	if o.Category != "" {
+		params["category"] = o.Category
+	}
+	if o.Tags != "" {
+		params["tags"] = o.Tags
+	}
+	if o.RootFolder != "" {
+		params["root_folder"] = o.RootFolder
+	}
+	if o.Rename != "" {
+		params["rename"] = o.Rename
+	}
+	if o.ContentLayout != "" {
+		params["contentLayout"] = o.ContentLayout
+	}
+	if o.Paused {
+		params["paused"] = "true"
+	}
+	if o.SkipChecking {
+		params["skip_checking"] = "true"
+	}
+	if o.AutoTMM {
+		params["autoTMM"] = "true"
+	}
+	if o.SequentialDownload {
+		params["sequentialDownload"] = "true"
+	}
+	if o.FirstLastPiecePrio {
+		params["firstLastPiecePrio"] = "true"
+	}
+	if o.UpLimit != 0 {
+		params["upLimit"] = strconv.FormatInt(o.UpLimit, 10)
+	}
+	if o.DlLimit != 0 {
+		params["dlLimit"] = strconv.FormatInt(o.DlLimit, 10)
+	}
+	if o.RatioLimit != 0 {
+		params["ratioLimit"] = strconv.FormatFloat(o.RatioLimit, 'f', -1, 64)
+	}
+	if o.SeedingTimeLimit != 0 {
+		params["seedingTimeLimit"] = strconv.FormatInt(o.SeedingTimeLimit, 10)
+	}
+	return params
+}
+
+/*
+Add one or more .torrent files on disk, using typed AddTorrentOptions
+instead of a raw opts map. Unlike AddTorrentFromFile this submits every
+path in a single multipart/form-data request.
+
+	paths	[]string	Paths to the .torrent files
+	opts	AddTorrentOptions	optional parameters
+*/
+func (client *Client) AddTorrentFile(paths []string, opts AddTorrentOptions) (*http.Response, error) {
+	return client.AddTorrentFileContext(context.Background(), paths, opts)
+}
+
+// AddTorrentFileContext is the context-aware variant of AddTorrentFile.
+func (client *Client) AddTorrentFileContext(ctx context.Context, paths []string, opts AddTorrentOptions) (*http.Response, error) {
+	files := make(map[string]io.Reader, len(paths))
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to open the torrent file")
+		}
+		defer file.Close()
+		files[filepath.Base(path)] = file
+	}
+
+	return client.postMultipartFiles(ctx, "torrents/add", opts.params(), files)
+}
+
+/*
+Add a torrent from an io.Reader using typed AddTorrentOptions instead of
+a raw opts map.
+
+	name	string	Name reported for the uploaded file, i.e. my.torrent
+	r	io.Reader	Torrent file contents
+	opts	AddTorrentOptions	optional parameters
+*/
+func (client *Client) AddTorrentFileReader(name string, r io.Reader, opts AddTorrentOptions) (*http.Response, error) {
+	return client.AddTorrentFileReaderContext(context.Background(), name, r, opts)
+}
+
+// AddTorrentFileReaderContext is the context-aware variant of AddTorrentFileReader.
+func (client *Client) AddTorrentFileReaderContext(ctx context.Context, name string, r io.Reader, opts AddTorrentOptions) (*http.Response, error) {
+	return client.AddTorrentFromReaderContext(ctx, name, r, opts.params())
+}
+
+/*
+Add one or more URLs or magnet links using typed AddTorrentOptions
+instead of a raw opts map.
+
+	urls	[]string	URLs or magnet links to the torrents
+	opts	AddTorrentOptions	optional parameters
+*/
+func (client *Client) AddTorrentURLs(urls []string, opts AddTorrentOptions) (*http.Response, error) {
+	return client.AddTorrentURLsContext(context.Background(), urls, opts)
+}
+
+// AddTorrentURLsContext is the context-aware variant of AddTorrentURLs.
+func (client *Client) AddTorrentURLsContext(ctx context.Context, urls []string, opts AddTorrentOptions) (*http.Response, error) {
+	return client.AddTorrentFromURLContext(ctx, strings.Join(urls, "\n"), opts.params())
 }
 
 /*
@@ -325,10 +1193,16 @@ https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#get-
 	hashes	string 	Filter by hashes. Can contain multiple hashes separated by |
 */
 func (client *Client) GetTorrentList(filters map[string]string) ([]BasicTorrent, error) {
+	return client.GetTorrentListContext(context.Background(), filters)
+}
+
+// GetTorrentListContext is the context-aware variant of GetTorrentList.
+func (client *Client) GetTorrentListContext(ctx context.Context, filters map[string]string) ([]BasicTorrent, error) {
 	var t []BasicTorrent
-	resp, err := client.get("torrents/info", filters)
-	json.NewDecoder(resp.Body).Decode(&t)
-	return t, err
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "torrents/info", filters)
+	}
+	return t, client.do(ctx, request, &t)
 }
 
 /*
@@ -337,14 +1211,19 @@ Get the torrent details
 	hash string Torrent hash value
 */
 func (client *Client) GetTorrent(hash string) (Torrent, error) {
+	return client.GetTorrentContext(context.Background(), hash)
+}
+
+// GetTorrentContext is the context-aware variant of GetTorrent.
+func (client *Client) GetTorrentContext(ctx context.Context, hash string) (Torrent, error) {
 	params := map[string]string{
 		"hash": hash,
 	}
 	var torrent Torrent
-	resp, err := client.get("torrents/properties", params)
-
-	json.NewDecoder(resp.Body).Decode(&torrent)
-	return torrent, err
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "torrents/properties", params)
+	}
+	return torrent, client.do(ctx, request, &torrent)
 }
 
 /*
@@ -353,14 +1232,19 @@ Get torrent's tracker data
 	hash	string Torrent hash value
 */
 func (client *Client) GetTrackers(hash string) ([]Tracker, error) {
+	return client.GetTrackersContext(context.Background(), hash)
+}
+
+// GetTrackersContext is the context-aware variant of GetTrackers.
+func (client *Client) GetTrackersContext(ctx context.Context, hash string) ([]Tracker, error) {
 	params := map[string]string{
 		"hash": hash,
 	}
 	var trackers []Tracker
-	resp, err := client.get("torrents/trackers", params)
-
-	json.NewDecoder(resp.Body).Decode(&trackers)
-	return trackers, err
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "torrents/trackers", params)
+	}
+	return trackers, client.do(ctx, request, &trackers)
 }
 
 /*
@@ -369,14 +1253,19 @@ Get torrent's webseeds data
 	hash	string Torrent hash value
 */
 func (client *Client) GetWebseeds(hash string) ([]WebSeed, error) {
+	return client.GetWebseedsContext(context.Background(), hash)
+}
+
+// GetWebseedsContext is the context-aware variant of GetWebseeds.
+func (client *Client) GetWebseedsContext(ctx context.Context, hash string) ([]WebSeed, error) {
 	params := map[string]string{
 		"hash": hash,
 	}
 	var webseeds []WebSeed
-	resp, err := client.get("torrents/webseeds", params)
-
-	json.NewDecoder(resp.Body).Decode(&webseeds)
-	return webseeds, err
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "torrents/webseeds", params)
+	}
+	return webseeds, client.do(ctx, request, &webseeds)
 }
 
 /*
@@ -385,14 +1274,84 @@ Get torrent's files
 	hash	string Torrent hash value
 */
 func (client *Client) GetTorrentFiles(hash string) ([]TorrentFile, error) {
+	return client.GetTorrentFilesContext(context.Background(), hash)
+}
+
+// GetTorrentFilesContext is the context-aware variant of GetTorrentFiles.
+func (client *Client) GetTorrentFilesContext(ctx context.Context, hash string) ([]TorrentFile, error) {
 	params := map[string]string{
 		"hash": hash,
 	}
 	var files []TorrentFile
-	resp, err := client.get("torrents/files", params)
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "torrents/files", params)
+	}
+	return files, client.do(ctx, request, &files)
+}
+
+// TorrentFilter holds the typed equivalent of the query parameters accepted
+// by torrents/info, for callers who would rather not build the map by hand.
+type TorrentFilter struct {
+	Filter   string
+	Category string
+	Tag      string
+	Sort     string
+	Reverse  bool
+	Limit    int
+	Offset   int
+	Hashes   []string
+}
+
+// params converts the filter into the map[string]string shape GetTorrentList expects.
+func (f TorrentFilter) params() map[string]string {
+	params := map[string]string{}
+	if f.Filter != "" {
+		params["filter"] = f.Filter
+	}
+	if f.Category != "" {
+		params["category"] = f.Category
+	}
+	if f.Tag != "" {
+		params["tag"] = f.Tag
+	}
+	if f.Sort != "" {
+		params["sort"] = f.Sort
+	}
+	if f.Reverse {
+		params["reverse"] = "true"
+	}
+	if f.Limit != 0 {
+		params["limit"] = strconv.Itoa(f.Limit)
+	}
+	if f.Offset != 0 {
+		params["offset"] = strconv.Itoa(f.Offset)
+	}
+	if len(f.Hashes) > 0 {
+		params["hashes"] = strings.Join(f.Hashes, "|")
+	}
+	return params
+}
 
-	json.NewDecoder(resp.Body).Decode(&files)
-	return files, err
+// GetTorrents is a typed-filter sibling of GetTorrentList, for callers who
+// would rather build a TorrentFilter than a raw map[string]string.
+func (client *Client) GetTorrents(filter TorrentFilter) ([]BasicTorrent, error) {
+	return client.GetTorrentsContext(context.Background(), filter)
+}
+
+// GetTorrentsContext is the context-aware variant of GetTorrents.
+func (client *Client) GetTorrentsContext(ctx context.Context, filter TorrentFilter) ([]BasicTorrent, error) {
+	return client.GetTorrentListContext(ctx, filter.params())
+}
+
+// GetTorrentTrackers is a sibling of GetTrackers with a name that matches
+// the "torrents/Xxx" getter family more closely.
+func (client *Client) GetTorrentTrackers(hash string) ([]Tracker, error) {
+	return client.GetTorrentTrackersContext(context.Background(), hash)
+}
+
+// GetTorrentTrackersContext is the context-aware variant of GetTorrentTrackers.
+func (client *Client) GetTorrentTrackersContext(ctx context.Context, hash string) ([]Tracker, error) {
+	return client.GetTrackersContext(ctx, hash)
 }
 
 /*
@@ -405,14 +1364,19 @@ Gets torrent's piece states
 	hash	string	Torrent hash value
 */
 func (client *Client) GetTorrentPieceStates(hash string) ([]int, error) {
+	return client.GetTorrentPieceStatesContext(context.Background(), hash)
+}
+
+// GetTorrentPieceStatesContext is the context-aware variant of GetTorrentPieceStates.
+func (client *Client) GetTorrentPieceStatesContext(ctx context.Context, hash string) ([]int, error) {
 	params := map[string]string{
 		"hash": hash,
 	}
 	var pieceStates []int
-	resp, err := client.get("torrents/pieceStates", params)
-
-	json.NewDecoder(resp.Body).Decode(&pieceStates)
-	return pieceStates, err
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "torrents/pieceStates", params)
+	}
+	return pieceStates, client.do(ctx, request, &pieceStates)
 }
 
 /*
@@ -421,14 +1385,19 @@ Get torrent's piece hashes
 	hash  string Torrent hash value
 */
 func (client *Client) GetTorrentPieceHashes(hash string) ([]int, error) {
+	return client.GetTorrentPieceHashesContext(context.Background(), hash)
+}
+
+// GetTorrentPieceHashesContext is the context-aware variant of GetTorrentPieceHashes.
+func (client *Client) GetTorrentPieceHashesContext(ctx context.Context, hash string) ([]int, error) {
 	params := map[string]string{
 		"hash": hash,
 	}
 	var pieceHashes []int
-	resp, err := client.get("torrents/pieceHashes", params)
-
-	json.NewDecoder(resp.Body).Decode(&pieceHashes)
-	return pieceHashes, err
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "torrents/pieceHashes", params)
+	}
+	return pieceHashes, client.do(ctx, request, &pieceHashes)
 }
 
 /*
@@ -437,10 +1406,15 @@ Pause torrent
 	hash string Torrent hash values
 */
 func (client *Client) Pause(hash string) (*http.Response, error) {
+	return client.PauseContext(context.Background(), hash)
+}
+
+// PauseContext is the context-aware variant of Pause.
+func (client *Client) PauseContext(ctx context.Context, hash string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": hash,
 	}
-	return client.get("torrents/pause", params)
+	return client.get(ctx, "torrents/pause", params)
 }
 
 /*
@@ -449,18 +1423,28 @@ Pause multiple torrents
 	hash []string Torrent hash values in an array
 */
 func (client *Client) PauseMultiple(hashes []string) (*http.Response, error) {
+	return client.PauseMultipleContext(context.Background(), hashes)
+}
+
+// PauseMultipleContext is the context-aware variant of PauseMultiple.
+func (client *Client) PauseMultipleContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
-	return client.get("torrents/pause", params)
+	return client.get(ctx, "torrents/pause", params)
 }
 
 /*
 Pause all torrents
 */
 func (client *Client) PauseAll() (*http.Response, error) {
+	return client.PauseAllContext(context.Background())
+}
+
+// PauseAllContext is the context-aware variant of PauseAll.
+func (client *Client) PauseAllContext(ctx context.Context) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": "all",
 	}
-	return client.get("torrents/pause", params)
+	return client.get(ctx, "torrents/pause", params)
 }
 
 /*
@@ -469,10 +1453,15 @@ Resume a torrent
 	hash string Torrent hash value
 */
 func (client *Client) Resume(hash string) (*http.Response, error) {
+	return client.ResumeContext(context.Background(), hash)
+}
+
+// ResumeContext is the context-aware variant of Resume.
+func (client *Client) ResumeContext(ctx context.Context, hash string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": hash,
 	}
-	return client.get("torrents/resume", params)
+	return client.get(ctx, "torrents/resume", params)
 }
 
 /*
@@ -481,18 +1470,28 @@ Resume multiple torrents
 	hash []string Torrent hash values in an array
 */
 func (client *Client) ResumeMultiple(hashes []string) (*http.Response, error) {
+	return client.ResumeMultipleContext(context.Background(), hashes)
+}
+
+// ResumeMultipleContext is the context-aware variant of ResumeMultiple.
+func (client *Client) ResumeMultipleContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
-	return client.get("torrents/resume", params)
+	return client.get(ctx, "torrents/resume", params)
 }
 
 /*
 Resume all torrents
 */
 func (client *Client) ResumeAll() (*http.Response, error) {
+	return client.ResumeAllContext(context.Background())
+}
+
+// ResumeAllContext is the context-aware variant of ResumeAll.
+func (client *Client) ResumeAllContext(ctx context.Context) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": "all",
 	}
-	return client.get("torrents/resume", params)
+	return client.get(ctx, "torrents/resume", params)
 }
 
 /*
@@ -502,11 +1501,16 @@ Delete a torrent
 	deleteFiles	string false or true. Set if you want to delete the files and not just remove the torrent from the client.
 */
 func (client *Client) Delete(hash string, deleteFiles string) (*http.Response, error) {
+	return client.DeleteContext(context.Background(), hash, deleteFiles)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (client *Client) DeleteContext(ctx context.Context, hash string, deleteFiles string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes":      hash,
 		"deleteFiles": strings.ToLower(deleteFiles),
 	}
-	return client.get("torrents/delete", params)
+	return client.get(ctx, "torrents/delete", params)
 }
 
 /*
@@ -516,9 +1520,14 @@ Delete multiple torrents
 	deleteFiles	string false or true. Set if you want to delete the files and not just remove the torrent from the client.
 */
 func (client *Client) DeleteMultiple(hashes []string, deleteFiles string) (*http.Response, error) {
+	return client.DeleteMultipleContext(context.Background(), hashes, deleteFiles)
+}
+
+// DeleteMultipleContext is the context-aware variant of DeleteMultiple.
+func (client *Client) DeleteMultipleContext(ctx context.Context, hashes []string, deleteFiles string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["deleteFiles"] = strings.ToLower(deleteFiles)
-	return client.get("torrents/delete", params)
+	return client.get(ctx, "torrents/delete", params)
 }
 
 /*
@@ -527,11 +1536,16 @@ Delete all torrents
 	deleteFiles	string false or true. Set if you want to delete the files and not just remove the torrent from the client.
 */
 func (client *Client) DeleteAll(deleteFiles string) (*http.Response, error) {
+	return client.DeleteAllContext(context.Background(), deleteFiles)
+}
+
+// DeleteAllContext is the context-aware variant of DeleteAll.
+func (client *Client) DeleteAllContext(ctx context.Context, deleteFiles string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes":      "all",
 		"deleteFiles": strings.ToLower(deleteFiles),
 	}
-	return client.get("torrents/delete", params)
+	return client.get(ctx, "torrents/delete", params)
 }
 
 /*
@@ -540,10 +1554,15 @@ Recheck a torrent
 	hash	string	Torrent hash value
 */
 func (client *Client) Recheck(hash string) (*http.Response, error) {
+	return client.RecheckContext(context.Background(), hash)
+}
+
+// RecheckContext is the context-aware variant of Recheck.
+func (client *Client) RecheckContext(ctx context.Context, hash string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": hash,
 	}
-	return client.get("torrents/recheck", params)
+	return client.get(ctx, "torrents/recheck", params)
 }
 
 /*
@@ -552,18 +1571,28 @@ Recheck multiple torrents
 	hash	[]string	Torrent hash values in an array
 */
 func (client *Client) RecheckMultiple(hashes []string) (*http.Response, error) {
+	return client.RecheckMultipleContext(context.Background(), hashes)
+}
+
+// RecheckMultipleContext is the context-aware variant of RecheckMultiple.
+func (client *Client) RecheckMultipleContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
-	return client.get("torrents/recheck", params)
+	return client.get(ctx, "torrents/recheck", params)
 }
 
 /*
 Recheck all torrents
 */
 func (client *Client) RecheckAll() (*http.Response, error) {
+	return client.RecheckAllContext(context.Background())
+}
+
+// RecheckAllContext is the context-aware variant of RecheckAll.
+func (client *Client) RecheckAllContext(ctx context.Context) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": "all",
 	}
-	return client.get("torrents/recheck", params)
+	return client.get(ctx, "torrents/recheck", params)
 }
 
 /*
@@ -572,10 +1601,15 @@ Reannounce a torrent
 	hash string Torrent hash value
 */
 func (client *Client) Reannounce(hash string) (*http.Response, error) {
+	return client.ReannounceContext(context.Background(), hash)
+}
+
+// ReannounceContext is the context-aware variant of Reannounce.
+func (client *Client) ReannounceContext(ctx context.Context, hash string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": hash,
 	}
-	return client.get("torrents/reannounce", params)
+	return client.get(ctx, "torrents/reannounce", params)
 }
 
 /*
@@ -584,18 +1618,110 @@ Reannounce multiple torrents
 	hash []string	Torrent hash values in an array
 */
 func (client *Client) ReannounceMultiple(hashes []string) (*http.Response, error) {
+	return client.ReannounceMultipleContext(context.Background(), hashes)
+}
+
+// ReannounceMultipleContext is the context-aware variant of ReannounceMultiple.
+func (client *Client) ReannounceMultipleContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
-	return client.get("torrents/reannounce", params)
+	return client.get(ctx, "torrents/reannounce", params)
 }
 
 /*
 Reannounce all torrents
 */
 func (client *Client) ReannounceAll() (*http.Response, error) {
+	return client.ReannounceAllContext(context.Background())
+}
+
+// ReannounceAllContext is the context-aware variant of ReannounceAll.
+func (client *Client) ReannounceAllContext(ctx context.Context) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": "all",
 	}
-	return client.get("torrents/reannounce", params)
+	return client.get(ctx, "torrents/reannounce", params)
+}
+
+// trackerStatusWorking is the torrents/trackers status code for a tracker
+// that has been contacted successfully and is in working order.
+const trackerStatusWorking = 2
+
+// ErrReannounceTookTooLong is returned by ReannounceUntilOK when no tracker
+// reaches a working status within ReannounceOptions.MaxAttempts.
+var ErrReannounceTookTooLong = errors.New("reannounce did not succeed within the allowed attempts")
+
+/*
+ReannounceOptions controls the retry behavior of ReannounceUntilOK.
+
+	Interval	time.Duration	Time to wait between reannounce attempts. Defaults to 7s
+	MaxAttempts	int	Maximum number of attempts before giving up. Defaults to 50
+	DeleteOnFailure	bool	Delete the torrent if no attempt succeeds
+*/
+type ReannounceOptions struct {
+	Interval        time.Duration
+	MaxAttempts     int
+	DeleteOnFailure bool
+}
+
+/*
+DefaultReannounceOptions returns the ReannounceOptions ReannounceUntilOK falls
+back to when Interval or MaxAttempts are left at their zero value: a 7s
+interval and 50 attempts.
+*/
+func DefaultReannounceOptions() ReannounceOptions {
+	return ReannounceOptions{
+		Interval:    7 * time.Second,
+		MaxAttempts: 50,
+	}
+}
+
+/*
+ReannounceUntilOK repeatedly reannounces a torrent until one of its trackers
+reports a working status, or the attempts are exhausted. This is the
+high-level retrying counterpart to the one-shot Reannounce above.
+
+	ctx	context.Context	Cancels the retry loop
+	hash	string	Torrent hash value
+	opts	ReannounceOptions	Retry policy. Zero values fall back to the defaults documented on ReannounceOptions
+*/
+func (client *Client) ReannounceUntilOK(ctx context.Context, hash string, opts ReannounceOptions) error {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = 7 * time.Second
+	}
+	maxAttempts := opts.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 50
+	}
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		trackers, err := client.GetTrackersContext(ctx, hash)
+		if err != nil {
+			return err
+		}
+		for _, tracker := range trackers {
+			if tracker.Status == trackerStatusWorking && tracker.NumPeers >= 0 {
+				return nil
+			}
+		}
+
+		if _, err := client.ReannounceContext(ctx, hash); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+
+	if opts.DeleteOnFailure {
+		if _, err := client.DeleteContext(ctx, hash, "true"); err != nil {
+			return err
+		}
+	}
+	return ErrReannounceTookTooLong
 }
 
 /*
@@ -605,7 +1731,12 @@ Add trackers to torrent. Needs:
 	urls	string Multiple allowed, separated by |
 */
 func (client *Client) AddTracker(trackers map[string]string) (*http.Response, error) {
-	return client.post("torrents/addTrackers", trackers)
+	return client.AddTrackerContext(context.Background(), trackers)
+}
+
+// AddTrackerContext is the context-aware variant of AddTracker.
+func (client *Client) AddTrackerContext(ctx context.Context, trackers map[string]string) (*http.Response, error) {
+	return client.post(ctx, "torrents/addTrackers", trackers)
 }
 
 /*
@@ -616,7 +1747,12 @@ Edit torrent trackers. Needs:
 	newUrl	string
 */
 func (client *Client) EditTracker(trackers map[string]string) (*http.Response, error) {
-	return client.post("torrents/editTracker", trackers)
+	return client.EditTrackerContext(context.Background(), trackers)
+}
+
+// EditTrackerContext is the context-aware variant of EditTracker.
+func (client *Client) EditTrackerContext(ctx context.Context, trackers map[string]string) (*http.Response, error) {
+	return client.post(ctx, "torrents/editTracker", trackers)
 }
 
 /*
@@ -626,7 +1762,12 @@ Remove trackers from torrent. Needs:
 	urls	string	URLs to remove, separated by |
 */
 func (client *Client) RemoveTrackers(trackers map[string]string) (*http.Response, error) {
-	return client.post("torrents/editTracker", trackers)
+	return client.RemoveTrackersContext(context.Background(), trackers)
+}
+
+// RemoveTrackersContext is the context-aware variant of RemoveTrackers.
+func (client *Client) RemoveTrackersContext(ctx context.Context, trackers map[string]string) (*http.Response, error) {
+	return client.post(ctx, "torrents/removeTrackers", trackers)
 }
 
 /*
@@ -636,7 +1777,12 @@ Add peers to torrent
 	peers 	string 	The peer to add, or multiple peers separated by a pipe |. Each peer is a colon-separated host:port
 */
 func (client *Client) AddPeers(peers map[string]string) (*http.Response, error) {
-	return client.post("torrents/addPeers", peers)
+	return client.AddPeersContext(context.Background(), peers)
+}
+
+// AddPeersContext is the context-aware variant of AddPeers.
+func (client *Client) AddPeersContext(ctx context.Context, peers map[string]string) (*http.Response, error) {
+	return client.post(ctx, "torrents/addPeers", peers)
 }
 
 /*
@@ -645,8 +1791,13 @@ Increase torrents' priority
 	hashes	[]string	Torrent hash values in an array
 */
 func (client *Client) IncreasePriority(hashes []string) (*http.Response, error) {
+	return client.IncreasePriorityContext(context.Background(), hashes)
+}
+
+// IncreasePriorityContext is the context-aware variant of IncreasePriority.
+func (client *Client) IncreasePriorityContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
-	return client.post("torrents/increasePrio", params)
+	return client.post(ctx, "torrents/increasePrio", params)
 }
 
 /*
@@ -655,8 +1806,13 @@ Decrease torrents' priority
 	hashes	[]string	Torrent hash values in an array
 */
 func (client *Client) DecreasePriority(hashes []string) (*http.Response, error) {
+	return client.DecreasePriorityContext(context.Background(), hashes)
+}
+
+// DecreasePriorityContext is the context-aware variant of DecreasePriority.
+func (client *Client) DecreasePriorityContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
-	return client.post("torrents/decreasePrio", params)
+	return client.post(ctx, "torrents/decreasePrio", params)
 }
 
 /*
@@ -665,8 +1821,13 @@ Set torrents' priority to maximum
 	hashes	[]string	Torrent hash values in an array
 */
 func (client *Client) MaximumPriority(hashes []string) (*http.Response, error) {
+	return client.MaximumPriorityContext(context.Background(), hashes)
+}
+
+// MaximumPriorityContext is the context-aware variant of MaximumPriority.
+func (client *Client) MaximumPriorityContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
-	return client.post("torrents/topPrio", params)
+	return client.post(ctx, "torrents/topPrio", params)
 }
 
 /*
@@ -675,19 +1836,73 @@ Set torrents' priority to minimum
 	hashes	[]string	Torrent hash values in an array
 */
 func (client *Client) MinimumPriority(hashes []string) (*http.Response, error) {
+	return client.MinimumPriorityContext(context.Background(), hashes)
+}
+
+// MinimumPriorityContext is the context-aware variant of MinimumPriority.
+func (client *Client) MinimumPriorityContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
-	return client.post("torrents/bottomPrio", params)
+	return client.post(ctx, "torrents/bottomPrio", params)
 }
 
+// File priority values accepted by torrents/filePrio.
+const (
+	FilePriorityDoNotDownload = 0
+	FilePriorityNormal        = 1
+	FilePriorityHigh          = 6
+	FilePriorityMaximal       = 7
+)
+
 /*
 Set torrent files' priority
 
-	hash	string 	The hash of the torrent
-	id	string 	File ids, separated by |
-	priority	int 	File priority to set (consult torrent contents API for possible values)
+	hash	string	The hash of the torrent
+	fileIDs	[]int	File ids to apply the priority to
+	priority	int	File priority to set, one of the FilePriority* constants
+*/
+func (client *Client) SetFilePriority(hash string, fileIDs []int, priority int) (*http.Response, error) {
+	return client.SetFilePriorityContext(context.Background(), hash, fileIDs, priority)
+}
+
+// SetFilePriorityContext is the context-aware variant of SetFilePriority.
+func (client *Client) SetFilePriorityContext(ctx context.Context, hash string, fileIDs []int, priority int) (*http.Response, error) {
+	ids := make([]string, len(fileIDs))
+	for i, id := range fileIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	params := map[string]string{
+		"hash":     hash,
+		"id":       strings.Join(ids, "|"),
+		"priority": strconv.Itoa(priority),
+	}
+	return client.post(ctx, "torrents/filePrio", params)
+}
+
+/*
+Set torrent files' priorities in bulk, grouping the given file ids by their
+target priority so each distinct priority is pushed in a single
+torrents/filePrio call.
+
+	hash	string	The hash of the torrent
+	priorities	map[int]int	File id to FilePriority* value
 */
-func (client *Client) SetFilePriority(params map[string]string) (*http.Response, error) {
-	return client.post("torrents/filePrio", params)
+func (client *Client) SetFilePriorities(hash string, priorities map[int]int) error {
+	return client.SetFilePrioritiesContext(context.Background(), hash, priorities)
+}
+
+// SetFilePrioritiesContext is the context-aware variant of SetFilePriorities.
+func (client *Client) SetFilePrioritiesContext(ctx context.Context, hash string, priorities map[int]int) error {
+	byPriority := map[int][]int{}
+	for fileID, priority := range priorities {
+		byPriority[priority] = append(byPriority[priority], fileID)
+	}
+
+	for priority, fileIDs := range byPriority {
+		if _, err := client.SetFilePriorityContext(ctx, hash, fileIDs, priority); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 /*
@@ -695,11 +1910,18 @@ Get torrents download limit speed
 
 	hashes	[]string	Torrent hash values in an array
 */
-func (client *Client) GetTorrentDownloadLimit(hashes []string) (string, error) {
+func (client *Client) GetTorrentDownloadLimit(hashes []string) (TorrentLimits, error) {
+	return client.GetTorrentDownloadLimitContext(context.Background(), hashes)
+}
+
+// GetTorrentDownloadLimitContext is the context-aware variant of GetTorrentDownloadLimit.
+func (client *Client) GetTorrentDownloadLimitContext(ctx context.Context, hashes []string) (TorrentLimits, error) {
 	params := client.processList("hashes", hashes)
-	resp, _ := client.post("torrents/downloadLimit", params)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), nil
+	var limits TorrentLimits
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.post(ctx, "torrents/downloadLimit", params)
+	}
+	return limits, client.do(ctx, request, &limits)
 }
 
 /*
@@ -709,9 +1931,14 @@ Set torrents' download speed limit
 	limit	string	Set download limit
 */
 func (client *Client) SetTorrentDownloadLimit(hashes []string, limit string) (*http.Response, error) {
+	return client.SetTorrentDownloadLimitContext(context.Background(), hashes, limit)
+}
+
+// SetTorrentDownloadLimitContext is the context-aware variant of SetTorrentDownloadLimit.
+func (client *Client) SetTorrentDownloadLimitContext(ctx context.Context, hashes []string, limit string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["limit"] = limit
-	return client.post("torrents/setDownloadLimit", params)
+	return client.post(ctx, "torrents/setDownloadLimit", params)
 }
 
 /*
@@ -720,22 +1947,34 @@ Set torrents' share limits
 	hashes can contain multiple hashes separated by | or set to all ratioLimit is the max ratio the torrent should be seeded until. -2 means the global limit should be used, -1 means no limit. seedingTimeLimit is the max amount of time (minutes) the torrent should be seeded. -2 means the global limit should be used, -1 means no limit.
 */
 func (client *Client) SetTorrentShareLimit(hashes []string, ratioLimit string, seedingTimeLimit string) (*http.Response, error) {
+	return client.SetTorrentShareLimitContext(context.Background(), hashes, ratioLimit, seedingTimeLimit)
+}
+
+// SetTorrentShareLimitContext is the context-aware variant of SetTorrentShareLimit.
+func (client *Client) SetTorrentShareLimitContext(ctx context.Context, hashes []string, ratioLimit string, seedingTimeLimit string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["ratioLimit"] = ratioLimit
 	params["seedingTimeLimit"] = seedingTimeLimit
-	return client.post("torrents/setShareLimits", params)
+	return client.post(ctx, "torrents/setShareLimits", params)
 }
 
 /*
-Get torrents' upload speed limit
+Get torrents upload limit speed
 
 	hashes	[]string	Torrent hash values in an array
 */
-func (client *Client) GetTorrentUploadLimit(hashes []string) (string, error) {
+func (client *Client) GetTorrentUploadLimit(hashes []string) (TorrentLimits, error) {
+	return client.GetTorrentUploadLimitContext(context.Background(), hashes)
+}
+
+// GetTorrentUploadLimitContext is the context-aware variant of GetTorrentUploadLimit.
+func (client *Client) GetTorrentUploadLimitContext(ctx context.Context, hashes []string) (TorrentLimits, error) {
 	params := client.processList("hashes", hashes)
-	resp, _ := client.post("torrents/uploadLimit", params)
-	data, _ := io.ReadAll(resp.Body)
-	return string(data), nil
+	var limits TorrentLimits
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.post(ctx, "torrents/uploadLimit", params)
+	}
+	return limits, client.do(ctx, request, &limits)
 }
 
 /*
@@ -745,9 +1984,14 @@ Set torrents' upload speed limit
 	limit	string	Set upload limit
 */
 func (client *Client) SetTorrentUploadLimit(hashes []string, limit string) (*http.Response, error) {
+	return client.SetTorrentUploadLimitContext(context.Background(), hashes, limit)
+}
+
+// SetTorrentUploadLimitContext is the context-aware variant of SetTorrentUploadLimit.
+func (client *Client) SetTorrentUploadLimitContext(ctx context.Context, hashes []string, limit string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["limit"] = limit
-	return client.post("torrents/setUploadLimit", params)
+	return client.post(ctx, "torrents/setUploadLimit", params)
 }
 
 /*
@@ -757,9 +2001,14 @@ Set torrents' save location
 	location string Save location
 */
 func (client *Client) SetTorrentLocation(hashes []string, location string) (*http.Response, error) {
+	return client.SetTorrentLocationContext(context.Background(), hashes, location)
+}
+
+// SetTorrentLocationContext is the context-aware variant of SetTorrentLocation.
+func (client *Client) SetTorrentLocationContext(ctx context.Context, hashes []string, location string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["location"] = location
-	return client.post("torrents/setLocation", params)
+	return client.post(ctx, "torrents/setLocation", params)
 }
 
 /*
@@ -769,11 +2018,16 @@ Set torrent's name
 	name	string	Torrent name
 */
 func (client *Client) SetTorrentName(hash string, name string) (*http.Response, error) {
+	return client.SetTorrentNameContext(context.Background(), hash, name)
+}
+
+// SetTorrentNameContext is the context-aware variant of SetTorrentName.
+func (client *Client) SetTorrentNameContext(ctx context.Context, hash string, name string) (*http.Response, error) {
 	params := map[string]string{
 		"hash": hash,
 		"name": name,
 	}
-	return client.post("torrents/rename", params)
+	return client.post(ctx, "torrents/rename", params)
 }
 
 /*
@@ -783,20 +2037,30 @@ Set torrent category
 	category	string	Category name
 */
 func (client *Client) SetTorrentCategory(hashes []string, category string) (*http.Response, error) {
+	return client.SetTorrentCategoryContext(context.Background(), hashes, category)
+}
+
+// SetTorrentCategoryContext is the context-aware variant of SetTorrentCategory.
+func (client *Client) SetTorrentCategoryContext(ctx context.Context, hashes []string, category string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["category"] = category
-	return client.post("torrents/setCategory", params)
+	return client.post(ctx, "torrents/setCategory", params)
 }
 
 /*
 Get categories and their save paths
 */
-func (client *Client) GetCategories() (map[string]interface{}, error) {
-	resp, _ := client.post("torrents/categories", nil)
-	byteValue, _ := io.ReadAll(resp.Body)
-	var data map[string]interface{}
-	json.Unmarshal([]byte(byteValue), &data)
-	return data, nil
+func (client *Client) GetCategories() (map[string]Category, error) {
+	return client.GetCategoriesContext(context.Background())
+}
+
+// GetCategoriesContext is the context-aware variant of GetCategories.
+func (client *Client) GetCategoriesContext(ctx context.Context) (map[string]Category, error) {
+	var categories map[string]Category
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.post(ctx, "torrents/categories", nil)
+	}
+	return categories, client.do(ctx, request, &categories)
 }
 
 /*
@@ -807,7 +2071,12 @@ Create a category
 		savePath	string	Save location
 */
 func (client *Client) CreateCategory(params map[string]string) (*http.Response, error) {
-	return client.post("torrents/createCategory", params)
+	return client.CreateCategoryContext(context.Background(), params)
+}
+
+// CreateCategoryContext is the context-aware variant of CreateCategory.
+func (client *Client) CreateCategoryContext(ctx context.Context, params map[string]string) (*http.Response, error) {
+	return client.post(ctx, "torrents/createCategory", params)
 }
 
 /*
@@ -818,7 +2087,12 @@ Edit a category
 		savePath	string	Save location
 */
 func (client *Client) EditCategory(params map[string]string) (*http.Response, error) {
-	return client.post("torrents/editCategory", params)
+	return client.EditCategoryContext(context.Background(), params)
+}
+
+// EditCategoryContext is the context-aware variant of EditCategory.
+func (client *Client) EditCategoryContext(ctx context.Context, params map[string]string) (*http.Response, error) {
+	return client.post(ctx, "torrents/editCategory", params)
 }
 
 /*
@@ -827,10 +2101,15 @@ Remove a category
 	category	string	Category name
 */
 func (client *Client) RemoveCategory(category string) (*http.Response, error) {
+	return client.RemoveCategoryContext(context.Background(), category)
+}
+
+// RemoveCategoryContext is the context-aware variant of RemoveCategory.
+func (client *Client) RemoveCategoryContext(ctx context.Context, category string) (*http.Response, error) {
 	params := map[string]string{
 		"category": category,
 	}
-	return client.post("torrents/removeCategories", params)
+	return client.post(ctx, "torrents/removeCategories", params)
 }
 
 /*
@@ -840,9 +2119,14 @@ Set torrents' tag
 	tag	string	Tag name
 */
 func (client *Client) SetTorrentTag(hashes []string, tag string) (*http.Response, error) {
+	return client.SetTorrentTagContext(context.Background(), hashes, tag)
+}
+
+// SetTorrentTagContext is the context-aware variant of SetTorrentTag.
+func (client *Client) SetTorrentTagContext(ctx context.Context, hashes []string, tag string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["tags"] = tag
-	return client.post("torrents/addTags", params)
+	return client.post(ctx, "torrents/addTags", params)
 }
 
 /*
@@ -852,20 +2136,30 @@ Remove torrents' tag
 	tag	string	Tag name
 */
 func (client *Client) RemoveTorrentTag(hashes []string, tag string) (*http.Response, error) {
+	return client.RemoveTorrentTagContext(context.Background(), hashes, tag)
+}
+
+// RemoveTorrentTagContext is the context-aware variant of RemoveTorrentTag.
+func (client *Client) RemoveTorrentTagContext(ctx context.Context, hashes []string, tag string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["tags"] = tag
-	return client.post("torrents/removeTags", params)
+	return client.post(ctx, "torrents/removeTags", params)
 }
 
 /*
 Get available tags
 */
-func (client *Client) GetTags() []interface{} {
-	resp, _ := client.post("torrents/tags", nil)
-	bytes, _ := io.ReadAll(resp.Body)
-	var data []interface{}
-	json.Unmarshal(bytes, &data)
-	return data
+func (client *Client) GetTags() ([]string, error) {
+	return client.GetTagsContext(context.Background())
+}
+
+// GetTagsContext is the context-aware variant of GetTags.
+func (client *Client) GetTagsContext(ctx context.Context) ([]string, error) {
+	var tags []string
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.post(ctx, "torrents/tags", nil)
+	}
+	return tags, client.do(ctx, request, &tags)
 }
 
 /*
@@ -874,10 +2168,15 @@ Create a tag
 	tag	string	Tag name
 */
 func (client *Client) CreateTag(tag string) (*http.Response, error) {
+	return client.CreateTagContext(context.Background(), tag)
+}
+
+// CreateTagContext is the context-aware variant of CreateTag.
+func (client *Client) CreateTagContext(ctx context.Context, tag string) (*http.Response, error) {
 	params := map[string]string{
 		"tags": tag,
 	}
-	return client.post("torrents/createTags", params)
+	return client.post(ctx, "torrents/createTags", params)
 }
 
 /*
@@ -886,10 +2185,15 @@ Delete a tag
 	tag	string	Tag name
 */
 func (client *Client) DeleteTag(tag string) (*http.Response, error) {
+	return client.DeleteTagContext(context.Background(), tag)
+}
+
+// DeleteTagContext is the context-aware variant of DeleteTag.
+func (client *Client) DeleteTagContext(ctx context.Context, tag string) (*http.Response, error) {
 	params := map[string]string{
 		"tags": tag,
 	}
-	return client.post("torrents/deleteTags", params)
+	return client.post(ctx, "torrents/deleteTags", params)
 }
 
 /*
@@ -898,9 +2202,14 @@ Set automatic torrent management (automatically set torrent's location to that o
 	hashes	[]string	Torrent hash values in an array
 */
 func (client *Client) SetAutomaticTorrentManagement(hashes []string) (*http.Response, error) {
+	return client.SetAutomaticTorrentManagementContext(context.Background(), hashes)
+}
+
+// SetAutomaticTorrentManagementContext is the context-aware variant of SetAutomaticTorrentManagement.
+func (client *Client) SetAutomaticTorrentManagementContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["enable"] = "true"
-	return client.post("torrents/setAutoManagement", params)
+	return client.post(ctx, "torrents/setAutoManagement", params)
 }
 
 /*
@@ -909,10 +2218,15 @@ Enable sequential download
 	hash	string	Torrent hash value
 */
 func (client *Client) SequentialDownload(hash string) (*http.Response, error) {
+	return client.SequentialDownloadContext(context.Background(), hash)
+}
+
+// SequentialDownloadContext is the context-aware variant of SequentialDownload.
+func (client *Client) SequentialDownloadContext(ctx context.Context, hash string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": hash,
 	}
-	return client.post("torrents/toggleSequentialDownload", params)
+	return client.post(ctx, "torrents/toggleSequentialDownload", params)
 }
 
 /*
@@ -921,18 +2235,28 @@ Enable sequential download for multiple torrents
 	hash	[]string	Torrent hash values in an array
 */
 func (client *Client) SequentialDownloadMultiple(hashes []string) (*http.Response, error) {
+	return client.SequentialDownloadMultipleContext(context.Background(), hashes)
+}
+
+// SequentialDownloadMultipleContext is the context-aware variant of SequentialDownloadMultiple.
+func (client *Client) SequentialDownloadMultipleContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
-	return client.post("torrents/toggleSequentialDownload", params)
+	return client.post(ctx, "torrents/toggleSequentialDownload", params)
 }
 
 /*
 Enable sequential download for all torrents
 */
 func (client *Client) SequentialDownloadAll() (*http.Response, error) {
+	return client.SequentialDownloadAllContext(context.Background())
+}
+
+// SequentialDownloadAllContext is the context-aware variant of SequentialDownloadAll.
+func (client *Client) SequentialDownloadAllContext(ctx context.Context) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": "all",
 	}
-	return client.post("torrents/toggleSequentialDownload", params)
+	return client.post(ctx, "torrents/toggleSequentialDownload", params)
 }
 
 /*
@@ -941,10 +2265,15 @@ Set first/last piece priority for a torrent
 	hash	string	Torrent hash value
 */
 func (client *Client) FirstLastPiecePriority(hash string) (*http.Response, error) {
+	return client.FirstLastPiecePriorityContext(context.Background(), hash)
+}
+
+// FirstLastPiecePriorityContext is the context-aware variant of FirstLastPiecePriority.
+func (client *Client) FirstLastPiecePriorityContext(ctx context.Context, hash string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": hash,
 	}
-	return client.post("torrents/toggleFirstLastPiecePrio", params)
+	return client.post(ctx, "torrents/toggleFirstLastPiecePrio", params)
 }
 
 /*
@@ -953,18 +2282,28 @@ Set first/last piece priority for multiple torrents
 	hash	[]string	Torrent hash values in an array
 */
 func (client *Client) FirstLastPiecePriorityMultiple(hashes []string) (*http.Response, error) {
+	return client.FirstLastPiecePriorityMultipleContext(context.Background(), hashes)
+}
+
+// FirstLastPiecePriorityMultipleContext is the context-aware variant of FirstLastPiecePriorityMultiple.
+func (client *Client) FirstLastPiecePriorityMultipleContext(ctx context.Context, hashes []string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
-	return client.post("torrents/toggleFirstLastPiecePrio", params)
+	return client.post(ctx, "torrents/toggleFirstLastPiecePrio", params)
 }
 
 /*
 Set first/last piece priority for all torrents
 */
 func (client *Client) FirstLastPiecePriorityAll() (*http.Response, error) {
+	return client.FirstLastPiecePriorityAllContext(context.Background())
+}
+
+// FirstLastPiecePriorityAllContext is the context-aware variant of FirstLastPiecePriorityAll.
+func (client *Client) FirstLastPiecePriorityAllContext(ctx context.Context) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": "all",
 	}
-	return client.post("torrents/toggleFirstLastPiecePrio", params)
+	return client.post(ctx, "torrents/toggleFirstLastPiecePrio", params)
 }
 
 /*
@@ -974,11 +2313,16 @@ Set force start setting (true or false)
 	forceStart	string	"true" or "false"
 */
 func (client *Client) SetForceStart(hash string, forceStart string) (*http.Response, error) {
+	return client.SetForceStartContext(context.Background(), hash, forceStart)
+}
+
+// SetForceStartContext is the context-aware variant of SetForceStart.
+func (client *Client) SetForceStartContext(ctx context.Context, hash string, forceStart string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": hash,
 		"value":  forceStart,
 	}
-	return client.post("torrents/setForceStart", params)
+	return client.post(ctx, "torrents/setForceStart", params)
 }
 
 /*
@@ -988,9 +2332,14 @@ Set force start setting (true or false) for multiple torrents
 	forceStart	string	"true" or "false"
 */
 func (client *Client) SetForceStartMultiple(hashes []string, forceStart string) (*http.Response, error) {
+	return client.SetForceStartMultipleContext(context.Background(), hashes, forceStart)
+}
+
+// SetForceStartMultipleContext is the context-aware variant of SetForceStartMultiple.
+func (client *Client) SetForceStartMultipleContext(ctx context.Context, hashes []string, forceStart string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["value"] = forceStart
-	return client.post("torrents/setForceStart", params)
+	return client.post(ctx, "torrents/setForceStart", params)
 }
 
 /*
@@ -999,11 +2348,16 @@ Set force start setting (true or false) for all torrents
 	forceStart	string	"true" or "false"
 */
 func (client *Client) SetForceStartAll(forceStart string) (*http.Response, error) {
+	return client.SetForceStartAllContext(context.Background(), forceStart)
+}
+
+// SetForceStartAllContext is the context-aware variant of SetForceStartAll.
+func (client *Client) SetForceStartAllContext(ctx context.Context, forceStart string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": "all",
 		"value":  forceStart,
 	}
-	return client.post("torrents/setForceStart", params)
+	return client.post(ctx, "torrents/setForceStart", params)
 }
 
 /*
@@ -1013,11 +2367,16 @@ Enable super seeding mode for a torrent
 	superSeeding	string	"true" or "false"
 */
 func (client *Client) SetSuperSeeding(hash string, superSeeding string) (*http.Response, error) {
+	return client.SetSuperSeedingContext(context.Background(), hash, superSeeding)
+}
+
+// SetSuperSeedingContext is the context-aware variant of SetSuperSeeding.
+func (client *Client) SetSuperSeedingContext(ctx context.Context, hash string, superSeeding string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": hash,
 		"value":  superSeeding,
 	}
-	return client.post("torrents/setSuperSeeding", params)
+	return client.post(ctx, "torrents/setSuperSeeding", params)
 }
 
 /*
@@ -1027,9 +2386,14 @@ Enable super seeding mode for multiple torrents
 	superSeeding	string	"true" or "false"
 */
 func (client *Client) SetSuperSeedingMultiple(hashes []string, superSeeding string) (*http.Response, error) {
+	return client.SetSuperSeedingMultipleContext(context.Background(), hashes, superSeeding)
+}
+
+// SetSuperSeedingMultipleContext is the context-aware variant of SetSuperSeedingMultiple.
+func (client *Client) SetSuperSeedingMultipleContext(ctx context.Context, hashes []string, superSeeding string) (*http.Response, error) {
 	params := client.processList("hashes", hashes)
 	params["value"] = superSeeding
-	return client.post("torrents/setSuperSeeding", params)
+	return client.post(ctx, "torrents/setSuperSeeding", params)
 }
 
 /*
@@ -1038,11 +2402,16 @@ Enable super seeding mode for all torrents
 	superSeeding	string	"true" or "false"
 */
 func (client *Client) SetSuperSeedingAll(superSeeding string) (*http.Response, error) {
+	return client.SetSuperSeedingAllContext(context.Background(), superSeeding)
+}
+
+// SetSuperSeedingAllContext is the context-aware variant of SetSuperSeedingAll.
+func (client *Client) SetSuperSeedingAllContext(ctx context.Context, superSeeding string) (*http.Response, error) {
 	params := map[string]string{
 		"hashes": "all",
 		"value":  superSeeding,
 	}
-	return client.post("torrents/setSuperSeeding", params)
+	return client.post(ctx, "torrents/setSuperSeeding", params)
 }
 
 /*
@@ -1053,12 +2422,17 @@ Rename a file
 	newPath	string	new file path
 */
 func (client *Client) RenameFile(hash string, oldPath string, newPath string) (*http.Response, error) {
+	return client.RenameFileContext(context.Background(), hash, oldPath, newPath)
+}
+
+// RenameFileContext is the context-aware variant of RenameFile.
+func (client *Client) RenameFileContext(ctx context.Context, hash string, oldPath string, newPath string) (*http.Response, error) {
 	params := map[string]string{
 		"hash":    hash,
 		"oldPath": oldPath,
 		"newPath": newPath,
 	}
-	return client.post("torrents/renameFile", params)
+	return client.post(ctx, "torrents/renameFile", params)
 }
 
 /*
@@ -1069,10 +2443,214 @@ Rename a folder
 	newPath	string	new file path
 */
 func (client *Client) RenameFolder(hash string, oldPath string, newPath string) (*http.Response, error) {
+	return client.RenameFolderContext(context.Background(), hash, oldPath, newPath)
+}
+
+// RenameFolderContext is the context-aware variant of RenameFolder.
+func (client *Client) RenameFolderContext(ctx context.Context, hash string, oldPath string, newPath string) (*http.Response, error) {
 	params := map[string]string{
 		"hash":    hash,
 		"oldPath": oldPath,
 		"newPath": newPath,
 	}
-	return client.post("torrents/renameFolder", params)
+	return client.post(ctx, "torrents/renameFolder", params)
+}
+
+/*
+Subscribe to an RSS feed
+
+	url	string	URL of the RSS feed
+	path	string	Full path of the folder to add the feed to, e.g. "Folder\Subfolder\Feed name". Empty adds the feed at the top level under its own title
+*/
+func (client *Client) AddFeed(url string, path string) (*http.Response, error) {
+	return client.AddFeedContext(context.Background(), url, path)
+}
+
+// AddFeedContext is the context-aware variant of AddFeed.
+func (client *Client) AddFeedContext(ctx context.Context, url string, path string) (*http.Response, error) {
+	params := map[string]string{"url": url}
+	if path != "" {
+		params["path"] = path
+	}
+	return client.post(ctx, "rss/addFeed", params)
+}
+
+/*
+Remove a feed or folder
+
+	path	string	Full path of the item to remove
+*/
+func (client *Client) RemoveItem(path string) (*http.Response, error) {
+	return client.RemoveItemContext(context.Background(), path)
+}
+
+// RemoveItemContext is the context-aware variant of RemoveItem.
+func (client *Client) RemoveItemContext(ctx context.Context, path string) (*http.Response, error) {
+	params := map[string]string{"path": path}
+	return client.post(ctx, "rss/removeItem", params)
+}
+
+/*
+Move or rename a feed or folder
+
+	itemPath	string	Current full path of the item
+	destPath	string	New full path of the item
+*/
+func (client *Client) MoveItem(itemPath string, destPath string) (*http.Response, error) {
+	return client.MoveItemContext(context.Background(), itemPath, destPath)
+}
+
+// MoveItemContext is the context-aware variant of MoveItem.
+func (client *Client) MoveItemContext(ctx context.Context, itemPath string, destPath string) (*http.Response, error) {
+	params := map[string]string{
+		"itemPath": itemPath,
+		"destPath": destPath,
+	}
+	return client.post(ctx, "rss/moveItem", params)
+}
+
+/*
+Refresh a feed, fetching it from its source immediately instead of
+waiting for the next scheduled refresh
+
+	itemPath	string	Full path of the feed
+*/
+func (client *Client) RefreshItem(itemPath string) (*http.Response, error) {
+	return client.RefreshItemContext(context.Background(), itemPath)
+}
+
+// RefreshItemContext is the context-aware variant of RefreshItem.
+func (client *Client) RefreshItemContext(ctx context.Context, itemPath string) (*http.Response, error) {
+	params := map[string]string{"itemPath": itemPath}
+	return client.post(ctx, "rss/refreshItem", params)
+}
+
+/*
+Get the tree of feeds and folders
+
+	withData	bool	Include each feed's articles in the response
+*/
+func (client *Client) GetAllItems(withData bool) (map[string]RSSItem, error) {
+	return client.GetAllItemsContext(context.Background(), withData)
+}
+
+// GetAllItemsContext is the context-aware variant of GetAllItems.
+func (client *Client) GetAllItemsContext(ctx context.Context, withData bool) (map[string]RSSItem, error) {
+	var items map[string]RSSItem
+	params := map[string]string{"withData": strconv.FormatBool(withData)}
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "rss/items", params)
+	}
+	return items, client.do(ctx, request, &items)
+}
+
+/*
+Mark an article, or an entire feed, as read
+
+	itemPath	string	Full path of the feed
+	articleID	string	Article to mark as read. Empty marks every article in the feed as read
+*/
+func (client *Client) MarkAsRead(itemPath string, articleID string) (*http.Response, error) {
+	return client.MarkAsReadContext(context.Background(), itemPath, articleID)
+}
+
+// MarkAsReadContext is the context-aware variant of MarkAsRead.
+func (client *Client) MarkAsReadContext(ctx context.Context, itemPath string, articleID string) (*http.Response, error) {
+	params := map[string]string{"itemPath": itemPath}
+	if articleID != "" {
+		params["articleId"] = articleID
+	}
+	return client.post(ctx, "rss/markAsRead", params)
+}
+
+/*
+Create or update an auto-download rule
+
+	ruleName	string	Rule name
+	rule	RSSRule	Rule definition
+*/
+func (client *Client) SetRule(ruleName string, rule RSSRule) (*http.Response, error) {
+	return client.SetRuleContext(context.Background(), ruleName, rule)
+}
+
+// SetRuleContext is the context-aware variant of SetRule.
+func (client *Client) SetRuleContext(ctx context.Context, ruleName string, rule RSSRule) (*http.Response, error) {
+	ruleDef, err := json.Marshal(rule)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to encode the rule definition")
+	}
+	params := map[string]string{
+		"ruleName": ruleName,
+		"ruleDef":  string(ruleDef),
+	}
+	return client.post(ctx, "rss/setRule", params)
+}
+
+/*
+Rename an auto-download rule
+
+	ruleName	string	Current rule name
+	newRuleName	string	New rule name
+*/
+func (client *Client) RenameRule(ruleName string, newRuleName string) (*http.Response, error) {
+	return client.RenameRuleContext(context.Background(), ruleName, newRuleName)
+}
+
+// RenameRuleContext is the context-aware variant of RenameRule.
+func (client *Client) RenameRuleContext(ctx context.Context, ruleName string, newRuleName string) (*http.Response, error) {
+	params := map[string]string{
+		"ruleName":    ruleName,
+		"newRuleName": newRuleName,
+	}
+	return client.post(ctx, "rss/renameRule", params)
+}
+
+/*
+Remove an auto-download rule
+
+	ruleName	string	Rule name
+*/
+func (client *Client) RemoveRule(ruleName string) (*http.Response, error) {
+	return client.RemoveRuleContext(context.Background(), ruleName)
+}
+
+// RemoveRuleContext is the context-aware variant of RemoveRule.
+func (client *Client) RemoveRuleContext(ctx context.Context, ruleName string) (*http.Response, error) {
+	params := map[string]string{"ruleName": ruleName}
+	return client.post(ctx, "rss/removeRule", params)
+}
+
+/*
+Get every auto-download rule
+*/
+func (client *Client) GetAllRules() (map[string]RSSRule, error) {
+	return client.GetAllRulesContext(context.Background())
+}
+
+// GetAllRulesContext is the context-aware variant of GetAllRules.
+func (client *Client) GetAllRulesContext(ctx context.Context) (map[string]RSSRule, error) {
+	var rules map[string]RSSRule
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "rss/rules", nil)
+	}
+	return rules, client.do(ctx, request, &rules)
+}
+
+/*
+Get the articles matched by a rule, grouped by feed URL
+
+	ruleName	string	Rule name
+*/
+func (client *Client) GetMatchingArticles(ruleName string) (map[string][]string, error) {
+	return client.GetMatchingArticlesContext(context.Background(), ruleName)
+}
+
+// GetMatchingArticlesContext is the context-aware variant of GetMatchingArticles.
+func (client *Client) GetMatchingArticlesContext(ctx context.Context, ruleName string) (map[string][]string, error) {
+	var articles map[string][]string
+	params := map[string]string{"ruleName": ruleName}
+	request := func(ctx context.Context) (*http.Response, error) {
+		return client.get(ctx, "rss/matchingArticles", params)
+	}
+	return articles, client.do(ctx, request, &articles)
 }
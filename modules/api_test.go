@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestReannounceUntilOKRetriesUntilWorking asserts the retry loop keeps
+// reannouncing until a tracker reports trackerStatusWorking, instead of
+// giving up after the first attempt.
+func TestReannounceUntilOKRetriesUntilWorking(t *testing.T) {
+	var trackerCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v2/torrents/trackers":
+			n := atomic.AddInt32(&trackerCalls, 1)
+			status := 0
+			if n >= 3 {
+				status = trackerStatusWorking
+			}
+			json.NewEncoder(w).Encode([]Tracker{{URL: "udp://tracker", Status: status, NumPeers: 1}})
+		case r.URL.Path == "/api/v2/torrents/reannounce":
+			w.Write([]byte("Ok."))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	err := client.ReannounceUntilOK(context.Background(), "deadbeef", ReannounceOptions{
+		Interval:    time.Millisecond,
+		MaxAttempts: 10,
+	})
+	if err != nil {
+		t.Fatalf("ReannounceUntilOK returned %v, want nil", err)
+	}
+	if got := atomic.LoadInt32(&trackerCalls); got != 3 {
+		t.Errorf("got %d torrents/trackers calls, want 3", got)
+	}
+}
+
+// TestReannounceUntilOKTooLong asserts ErrReannounceTookTooLong is returned,
+// and the torrent deleted, once MaxAttempts is exhausted with DeleteOnFailure set.
+func TestReannounceUntilOKTooLong(t *testing.T) {
+	var deleteCalled int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/torrents/trackers":
+			json.NewEncoder(w).Encode([]Tracker{{URL: "udp://tracker", Status: 0, NumPeers: 0}})
+		case "/api/v2/torrents/reannounce":
+			w.Write([]byte("Ok."))
+		case "/api/v2/torrents/delete":
+			atomic.AddInt32(&deleteCalled, 1)
+			w.Write([]byte("Ok."))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	err := client.ReannounceUntilOK(context.Background(), "deadbeef", ReannounceOptions{
+		Interval:        time.Millisecond,
+		MaxAttempts:     3,
+		DeleteOnFailure: true,
+	})
+	if err != ErrReannounceTookTooLong {
+		t.Fatalf("got err %v, want ErrReannounceTookTooLong", err)
+	}
+	if atomic.LoadInt32(&deleteCalled) != 1 {
+		t.Error("DeleteOnFailure did not result in a torrents/delete call")
+	}
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMergeServerStateIgnoresOmittedKeys(t *testing.T) {
+	state := ServerState{DlInfoSpeed: 500, Queueing: true}
+
+	var delta ServerState
+	if err := json.Unmarshal([]byte(`{"up_info_speed":200}`), &delta); err != nil {
+		t.Fatalf("failed to unmarshal delta: %v", err)
+	}
+
+	mergeServerState(&state, delta)
+
+	if state.DlInfoSpeed != 500 {
+		t.Errorf("DlInfoSpeed = %d, want 500 (unmentioned key must be left alone)", state.DlInfoSpeed)
+	}
+	if !state.Queueing {
+		t.Error("Queueing was clobbered to false by a delta that didn't mention it")
+	}
+	if state.UpInfoSpeed != 200 {
+		t.Errorf("UpInfoSpeed = %d, want 200 (present key must be applied)", state.UpInfoSpeed)
+	}
+}
+
+func TestMergeServerStateAppliesExplicitZero(t *testing.T) {
+	state := ServerState{DlInfoSpeed: 500}
+
+	var delta ServerState
+	if err := json.Unmarshal([]byte(`{"dl_info_speed":0}`), &delta); err != nil {
+		t.Fatalf("failed to unmarshal delta: %v", err)
+	}
+
+	mergeServerState(&state, delta)
+
+	if state.DlInfoSpeed != 0 {
+		t.Errorf("DlInfoSpeed = %d, want 0 (explicit zero in delta must overwrite)", state.DlInfoSpeed)
+	}
+}
@@ -0,0 +1,306 @@
+package api
+
+import "encoding/json"
+
+// BasicTorrent is the per-torrent entry returned by torrents/info.
+type BasicTorrent struct {
+	AddedOn           int64   `json:"added_on"`
+	AmountLeft        int64   `json:"amount_left"`
+	AutoTMM           bool    `json:"auto_tmm"`
+	Category          string  `json:"category"`
+	Completed         int64   `json:"completed"`
+	CompletionOn      int64   `json:"completion_on"`
+	ContentPath       string  `json:"content_path"`
+	DlLimit           int64   `json:"dl_limit"`
+	Dlspeed           int64   `json:"dlspeed"`
+	Downloaded        int64   `json:"downloaded"`
+	DownloadedSession int64   `json:"downloaded_session"`
+	ETA               int64   `json:"eta"`
+	ForceStart        bool    `json:"force_start"`
+	Hash              string  `json:"hash"`
+	MagnetURI         string  `json:"magnet_uri"`
+	Name              string  `json:"name"`
+	NumLeechs         int     `json:"num_leechs"`
+	NumSeeds          int     `json:"num_seeds"`
+	Priority          int     `json:"priority"`
+	Progress          float64 `json:"progress"`
+	Ratio             float64 `json:"ratio"`
+	SavePath          string  `json:"save_path"`
+	SeqDL             bool    `json:"seq_dl"`
+	Size              int64   `json:"size"`
+	State             string  `json:"state"`
+	SuperSeeding      bool    `json:"super_seeding"`
+	Tags              string  `json:"tags"`
+	TimeActive        int64   `json:"time_active"`
+	TotalSize         int64   `json:"total_size"`
+	Tracker           string  `json:"tracker"`
+	UpLimit           int64   `json:"up_limit"`
+	Uploaded          int64   `json:"uploaded"`
+	UploadedSession   int64   `json:"uploaded_session"`
+	Upspeed           int64   `json:"upspeed"`
+}
+
+// Torrent is the detailed per-torrent response returned by torrents/properties.
+type Torrent struct {
+	AdditionDate           int64   `json:"addition_date"`
+	Comment                string  `json:"comment"`
+	CompletionDate         int64   `json:"completion_date"`
+	CreatedBy              string  `json:"created_by"`
+	DlLimit                int64   `json:"dl_limit"`
+	DlSpeed                int64   `json:"dl_speed"`
+	DlSpeedAvg             int64   `json:"dl_speed_avg"`
+	Eta                    int64   `json:"eta"`
+	Hash                   string  `json:"hash"`
+	LastSeen               int64   `json:"last_seen"`
+	NbConnections          int     `json:"nb_connections"`
+	NbConnectionsLimit     int     `json:"nb_connections_limit"`
+	Peers                  int     `json:"peers"`
+	PeersTotal             int     `json:"peers_total"`
+	PieceSize              int64   `json:"piece_size"`
+	PiecesHave             int     `json:"pieces_have"`
+	PiecesNum              int     `json:"pieces_num"`
+	Reannounce             int64   `json:"reannounce"`
+	SavePath               string  `json:"save_path"`
+	SeedingTime            int64   `json:"seeding_time"`
+	Seeds                  int     `json:"seeds"`
+	SeedsTotal             int     `json:"seeds_total"`
+	ShareRatio             float64 `json:"share_ratio"`
+	TimeElapsed            int64   `json:"time_elapsed"`
+	TotalSize              int64   `json:"total_size"`
+	TotalUploaded          int64   `json:"total_uploaded"`
+	TotalUploadedSession   int64   `json:"total_uploaded_session"`
+	TotalDownloaded        int64   `json:"total_downloaded"`
+	TotalDownloadedSession int64   `json:"total_downloaded_session"`
+	UpLimit                int64   `json:"up_limit"`
+	UpSpeed                int64   `json:"up_speed"`
+	UpSpeedAvg             int64   `json:"up_speed_avg"`
+}
+
+// Tracker is a single tracker entry returned by torrents/trackers.
+//
+//	Status	int	0 Disabled, 1 Not contacted, 2 Working, 3 Updating, 4 Not working
+type Tracker struct {
+	URL           string `json:"url"`
+	Status        int    `json:"status"`
+	Tier          int    `json:"tier"`
+	NumPeers      int    `json:"num_peers"`
+	NumSeeds      int    `json:"num_seeds"`
+	NumLeeches    int    `json:"num_leeches"`
+	NumDownloaded int    `json:"num_downloaded"`
+	Msg           string `json:"msg"`
+}
+
+// WebSeed is a single webseed entry returned by torrents/webseeds.
+type WebSeed struct {
+	URL string `json:"url"`
+}
+
+// TorrentFile is a single file entry returned by torrents/files.
+type TorrentFile struct {
+	Index        int     `json:"index"`
+	Name         string  `json:"name"`
+	Size         int64   `json:"size"`
+	Progress     float64 `json:"progress"`
+	Priority     int     `json:"priority"`
+	IsSeed       bool    `json:"is_seed"`
+	PieceRange   []int   `json:"piece_range"`
+	Availability float64 `json:"availability"`
+}
+
+// TorrentProperties is an alias for Torrent, matching the name callers
+// migrating from other qBittorrent client libraries tend to expect.
+type TorrentProperties = Torrent
+
+// Category is a single entry returned by torrents/categories.
+type Category struct {
+	Name     string `json:"name"`
+	SavePath string `json:"savePath"`
+}
+
+// Preferences mirrors the subset of app/preferences commonly consumed by callers.
+type Preferences struct {
+	Locale               string `json:"locale"`
+	SavePath             string `json:"save_path"`
+	TempPathEnabled      bool   `json:"temp_path_enabled"`
+	TempPath             string `json:"temp_path"`
+	AutoTmmEnabled       bool   `json:"auto_tmm_enabled"`
+	MaxActiveDownloads   int    `json:"max_active_downloads"`
+	MaxActiveTorrents    int    `json:"max_active_torrents"`
+	MaxActiveUploads     int    `json:"max_active_uploads"`
+	DlLimit              int64  `json:"dl_limit"`
+	UpLimit              int64  `json:"up_limit"`
+	MaxConnec            int    `json:"max_connec"`
+	MaxConnecPerTorrent  int    `json:"max_connec_per_torrent"`
+	MaxUploadsPerTorrent int    `json:"max_uploads_per_torrent"`
+	ListenPort           int    `json:"listen_port"`
+	Dht                  bool   `json:"dht"`
+	Pex                  bool   `json:"pex"`
+	Lsd                  bool   `json:"lsd"`
+	Encryption           int    `json:"encryption"`
+	QueueingEnabled      bool   `json:"queueing_enabled"`
+	WebUIUsername        string `json:"web_ui_username"`
+	AltDlLimit           int64  `json:"alt_dl_limit"`
+	AltUpLimit           int64  `json:"alt_up_limit"`
+	SchedulerEnabled     bool   `json:"scheduler_enabled"`
+}
+
+// AppPreferences is an alias for Preferences, matching the name callers
+// migrating from other qBittorrent client libraries tend to expect.
+type AppPreferences = Preferences
+
+// ServerState is the "server_state" section of sync/maindata.
+type ServerState struct {
+	ConnectionStatus string `json:"connection_status"`
+	DhtNodes         int    `json:"dht_nodes"`
+	DlInfoData       int64  `json:"dl_info_data"`
+	DlInfoSpeed      int64  `json:"dl_info_speed"`
+	DlRateLimit      int64  `json:"dl_rate_limit"`
+	UpInfoData       int64  `json:"up_info_data"`
+	UpInfoSpeed      int64  `json:"up_info_speed"`
+	UpRateLimit      int64  `json:"up_rate_limit"`
+	Queueing         bool   `json:"queueing"`
+	RefreshInterval  int64  `json:"refresh_interval"`
+	FreeSpaceOnDisk  int64  `json:"free_space_on_disk"`
+
+	// present records which JSON keys were in the decoded object, so a
+	// partial sync/maindata update can tell "field omitted" apart from
+	// "field explicitly set to its zero value" when merging.
+	present map[string]bool
+}
+
+// UnmarshalJSON decodes the server_state object and records which keys were
+// present, since qBittorrent's partial sync/maindata updates only include
+// the keys that changed.
+func (s *ServerState) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	type alias ServerState
+	if err := json.Unmarshal(data, (*alias)(s)); err != nil {
+		return err
+	}
+
+	s.present = make(map[string]bool, len(raw))
+	for key := range raw {
+		s.present[key] = true
+	}
+	return nil
+}
+
+// MainData is the decoded response of sync/maindata. Per qBittorrent's sync
+// API, non-full responses only carry the torrents/categories/tags that
+// changed since the requested rid, plus the removed keys.
+type MainData struct {
+	Rid               int64                   `json:"rid"`
+	FullUpdate        bool                    `json:"full_update"`
+	Torrents          map[string]BasicTorrent `json:"torrents"`
+	TorrentsRemoved   []string                `json:"torrents_removed"`
+	Categories        map[string]Category     `json:"categories"`
+	CategoriesRemoved []string                `json:"categories_removed"`
+	Tags              []string                `json:"tags"`
+	TagsRemoved       []string                `json:"tags_removed"`
+	Trackers          map[string][]string     `json:"trackers"`
+	ServerState       ServerState             `json:"server_state"`
+}
+
+// TransferInfo is the decoded response of transfer/info.
+type TransferInfo struct {
+	DlInfoSpeed      int64  `json:"dl_info_speed"`
+	DlInfoData       int64  `json:"dl_info_data"`
+	UpInfoSpeed      int64  `json:"up_info_speed"`
+	UpInfoData       int64  `json:"up_info_data"`
+	DlRateLimit      int64  `json:"dl_rate_limit"`
+	UpRateLimit      int64  `json:"up_rate_limit"`
+	DhtNodes         int    `json:"dht_nodes"`
+	ConnectionStatus string `json:"connection_status"`
+}
+
+// TorrentLimits maps a torrent hash to a speed limit, as returned by the
+// torrents/downloadLimit and torrents/uploadLimit endpoints.
+type TorrentLimits map[string]int64
+
+// RSSArticle is a single entry inside an RSS feed, as returned by
+// rss/items when withData is true.
+type RSSArticle struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Date        string `json:"date"`
+	Link        string `json:"link"`
+	TorrentURL  string `json:"torrentURL"`
+	Description string `json:"description"`
+	Author      string `json:"author"`
+	IsRead      bool   `json:"isRead"`
+}
+
+/*
+RSSItem is a node in the tree returned by rss/items. A node is either a
+folder, in which case Items holds its children keyed by name, or a feed,
+in which case the remaining fields are populated, and Articles too when
+rss/items was asked withData. A node can be told apart by checking
+whether Items is nil.
+*/
+type RSSItem struct {
+	UID           string
+	URL           string
+	Title         string
+	LastBuildDate string
+	IsLoading     bool
+	HasError      bool
+	Articles      []RSSArticle
+	Items         map[string]RSSItem
+}
+
+// UnmarshalJSON tells a feed node (has an "articles" key, even if empty, or
+// a string "url" key) apart from a folder node (a plain object of child
+// nodes keyed by name).
+func (item *RSSItem) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	_, hasArticles := raw["articles"]
+	feedURL, hasURL := raw["url"]
+	if hasArticles || (hasURL && len(feedURL) > 0 && feedURL[0] == '"') {
+		type feedItem RSSItem
+		var feed feedItem
+		if err := json.Unmarshal(data, &feed); err != nil {
+			return err
+		}
+		*item = RSSItem(feed)
+		return nil
+	}
+
+	item.Items = make(map[string]RSSItem, len(raw))
+	for name, value := range raw {
+		var child RSSItem
+		if err := json.Unmarshal(value, &child); err != nil {
+			return err
+		}
+		item.Items[name] = child
+	}
+	return nil
+}
+
+/*
+RSSRule is an auto-download rule, as accepted and returned by the
+rss/setRule and rss/rules endpoints.
+*/
+type RSSRule struct {
+	Enabled                   bool     `json:"enabled"`
+	MustContain               string   `json:"mustContain"`
+	MustNotContain            string   `json:"mustNotContain"`
+	UseRegex                  bool     `json:"useRegex"`
+	EpisodeFilter             string   `json:"episodeFilter"`
+	SmartFilter               bool     `json:"smartFilter"`
+	PreviouslyMatchedEpisodes []string `json:"previouslyMatchedEpisodes"`
+	AffectedFeeds             []string `json:"affectedFeeds"`
+	IgnoreDays                int      `json:"ignoreDays"`
+	LastMatch                 string   `json:"lastMatch"`
+	AddPaused                 bool     `json:"addPaused"`
+	AssignedCategory          string   `json:"assignedCategory"`
+	SavePath                  string   `json:"savePath"`
+	TorrentContentLayout      string   `json:"torrentContentLayout"`
+}
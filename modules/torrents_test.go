@@ -0,0 +1,22 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+// TestGetTorrentsReturnsErrorOnUnreachableHost asserts that GetTorrentsContext
+// and GetTorrentTrackersContext, thin wrappers around GetTorrentListContext
+// and GetTrackersContext, surface a connection error instead of panicking
+// when the underlying request never gets a response.
+func TestGetTorrentsReturnsErrorOnUnreachableHost(t *testing.T) {
+	client := NewClient("http://127.0.0.1:1")
+
+	if _, err := client.GetTorrentsContext(context.Background(), TorrentFilter{}); err == nil {
+		t.Error("GetTorrentsContext returned a nil error against an unreachable host")
+	}
+
+	if _, err := client.GetTorrentTrackersContext(context.Background(), "deadbeef"); err == nil {
+		t.Error("GetTorrentTrackersContext returned a nil error against an unreachable host")
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Package rssagg is an optional local RSS aggregator: it polls external feeds
+directly with gofeed instead of relying on qBittorrent's own rss/addFeed
+subscription, applies caller-defined filters to each item, and forwards
+matches straight into a qbittorrent-go Client. It exists for filter logic
+richer than the server-side auto-download rules support (arbitrary Go
+predicates instead of wildcard/regex title matching).
+*/
+package rssagg
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/6ftclaud/qbittorrent-go/modules"
+	"github.com/mmcdole/gofeed"
+)
+
+/*
+Filter decides which items of a Feed are forwarded to qBittorrent.
+
+	MustContain	string	The item's title must match this to be forwarded. Empty matches everything
+	MustNotContain	string	The item's title is never forwarded if it matches this. Empty disables the check
+	UseRegex	bool	Treat MustContain/MustNotContain as regular expressions instead of plain substrings
+	Match	func(*gofeed.Item) bool	Arbitrary predicate evaluated after the title checks above, for filter logic they can't express. Nil accepts everything
+*/
+type Filter struct {
+	MustContain    string
+	MustNotContain string
+	UseRegex       bool
+	Match          func(item *gofeed.Item) bool
+}
+
+// matches reports whether item passes f.
+func (f Filter) matches(item *gofeed.Item) bool {
+	if f.MustContain != "" && !f.find(f.MustContain, item.Title) {
+		return false
+	}
+	if f.MustNotContain != "" && f.find(f.MustNotContain, item.Title) {
+		return false
+	}
+	if f.Match != nil && !f.Match(item) {
+		return false
+	}
+	return true
+}
+
+// find reports whether title matches pattern, as a regular expression when
+// f.UseRegex is set or as a plain substring otherwise.
+func (f Filter) find(pattern string, title string) bool {
+	if !f.UseRegex {
+		return strings.Contains(title, pattern)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(title)
+}
+
+/*
+Feed is a single externally-polled RSS feed.
+
+	URL	string	Feed URL, polled directly with gofeed
+	Filter	Filter	Decides which items of this feed are forwarded
+	Options	api.AddTorrentOptions	Applied to every torrent forwarded from this feed
+*/
+type Feed struct {
+	URL     string
+	Filter  Filter
+	Options api.AddTorrentOptions
+}
+
+/*
+Config configures an Aggregator.
+
+	Feeds	[]Feed	Feeds to poll
+	PollInterval	time.Duration	Time between polls of every feed. Defaults to 15m
+	Logger	api.Logger	Logger used for polling and forwarding activity. Defaults to a no-op logger
+*/
+type Config struct {
+	Feeds        []Feed
+	PollInterval time.Duration
+	Logger       api.Logger
+}
+
+// nopLogger discards everything; it's the Logger default when Config.Logger is nil.
+type nopLogger struct{}
+
+func (nopLogger) Debug(args ...interface{}) {}
+func (nopLogger) Info(args ...interface{})  {}
+
+// Aggregator polls Config.Feeds on a timer and forwards items that pass
+// their Filter to a qbittorrent-go Client.
+type Aggregator struct {
+	client *api.Client
+	config Config
+	parser *gofeed.Parser
+
+	mu   sync.Mutex
+	seen map[string]map[string]bool
+}
+
+// New creates an Aggregator. Zero-valued fields in cfg fall back to the
+// values documented on Config.
+func New(client *api.Client, cfg Config) *Aggregator {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 15 * time.Minute
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = nopLogger{}
+	}
+	return &Aggregator{
+		client: client,
+		config: cfg,
+		parser: gofeed.NewParser(),
+		seen:   make(map[string]map[string]bool, len(cfg.Feeds)),
+	}
+}
+
+// Run takes a baseline poll of every configured feed, marking all items
+// currently in each feed as seen without forwarding them, then polls again
+// every Config.PollInterval and forwards newly-appeared items until ctx is
+// canceled.
+func (a *Aggregator) Run(ctx context.Context) error {
+	a.pollAll(ctx, true)
+
+	ticker := time.NewTicker(a.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			a.pollAll(ctx, false)
+		}
+	}
+}
+
+func (a *Aggregator) pollAll(ctx context.Context, baseline bool) {
+	for _, feed := range a.config.Feeds {
+		a.poll(ctx, feed, baseline)
+	}
+}
+
+// poll fetches feed.URL and forwards any new item that passes feed.Filter.
+// When baseline is true, every item is recorded as seen but none are
+// forwarded; this is used on the very first poll so pre-existing feed
+// history isn't submitted as if it had just appeared.
+func (a *Aggregator) poll(ctx context.Context, feed Feed, baseline bool) {
+	parsed, err := a.parser.ParseURLWithContext(feed.URL, ctx)
+	if err != nil {
+		a.config.Logger.Info("Failed to poll ", feed.URL, ": ", err)
+		return
+	}
+
+	seen := a.seenSet(feed.URL)
+	for _, item := range parsed.Items {
+		id := item.GUID
+		if id == "" {
+			id = item.Link
+		}
+
+		a.mu.Lock()
+		alreadySeen := seen[id]
+		seen[id] = true
+		a.mu.Unlock()
+
+		if baseline || alreadySeen || !feed.Filter.matches(item) {
+			continue
+		}
+
+		if _, err := a.client.AddTorrentURLsContext(ctx, []string{a.itemURL(item)}, feed.Options); err != nil {
+			a.config.Logger.Info("Failed to forward ", item.Title, " from ", feed.URL, ": ", err)
+			continue
+		}
+		a.config.Logger.Info("Forwarded ", item.Title, " from ", feed.URL)
+	}
+}
+
+// itemURL picks the URL to submit to qBittorrent for item: its enclosure
+// when it has one (usually the .torrent/magnet link), its link otherwise.
+func (a *Aggregator) itemURL(item *gofeed.Item) string {
+	if len(item.Enclosures) > 0 && item.Enclosures[0].URL != "" {
+		return item.Enclosures[0].URL
+	}
+	return item.Link
+}
+
+// seenSet returns, creating it if necessary, the set of item IDs already
+// seen in feedURL, whether or not they were forwarded.
+func (a *Aggregator) seenSet(feedURL string) map[string]bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	seen, ok := a.seen[feedURL]
+	if !ok {
+		seen = make(map[string]bool)
+		a.seen[feedURL] = seen
+	}
+	return seen
+}
@@ -0,0 +1,575 @@
+/*
+Package transmissionrpc exposes an http.Handler implementing enough of the
+Transmission RPC protocol (https://github.com/transmission/transmission/blob/main/docs/rpc-spec.md)
+for Transmission-aware tools (Sonarr, Radarr, transmission-remote, mobile
+apps) to drive a qBittorrent instance unchanged, translating each call
+into the equivalent qbittorrent-go Client calls.
+
+Implemented methods are session-get, session-stats, torrent-get,
+torrent-add, torrent-remove and torrent-set. Anything else, including the
+whole session-set, queue-move, port-test and blocklist-update family,
+returns a "method not implemented" result rather than silently succeeding.
+
+Transmission identifies torrents by a small integer id; qBittorrent only
+ever deals in their 40-character hash. Handler keeps an in-memory,
+process-lifetime mapping between the two, assigning new ids the first
+time a torrent is seen by torrent-get. A restart resets the mapping, so
+callers that persist ids across restarts (not a pattern actual
+Transmission clients use) will see them change.
+
+Several torrent-get/torrent-set fields have no qBittorrent equivalent and
+are always omitted or ignored: bandwidthPriority, honorsSessionLimits,
+labels, peer-limit, queuePosition and the tracker add/remove/replace
+triple. torrent-add never reports "torrent-duplicate", since qBittorrent's
+add endpoints don't distinguish a duplicate from a fresh add in their
+response.
+*/
+package transmissionrpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	api "github.com/6ftclaud/qbittorrent-go/modules"
+)
+
+// SessionIDHeader is the CSRF header Transmission RPC requires on every
+// request once a session has been established.
+const SessionIDHeader = "X-Transmission-Session-Id"
+
+type rpcRequest struct {
+	Method    string          `json:"method"`
+	Arguments json.RawMessage `json:"arguments"`
+	Tag       int             `json:"tag,omitempty"`
+}
+
+type rpcResponse struct {
+	Result    string      `json:"result"`
+	Arguments interface{} `json:"arguments,omitempty"`
+	Tag       int         `json:"tag,omitempty"`
+}
+
+/*
+Handler is an http.Handler speaking the Transmission RPC protocol in
+front of a qbittorrent-go Client.
+*/
+type Handler struct {
+	client    *api.Client
+	sessionID string
+
+	mu       sync.Mutex
+	idToHash map[int]string
+	hashToID map[string]int
+	nextID   int
+}
+
+// New creates a Handler wrapping client. A random session id is generated
+// once, at construction, and enforced for the Handler's lifetime.
+func New(client *api.Client) *Handler {
+	return &Handler{
+		client:    client,
+		sessionID: newSessionID(),
+		idToHash:  make(map[int]string),
+		hashToID:  make(map[string]int),
+		nextID:    1,
+	}
+}
+
+func newSessionID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "0000000000000000000000000000000"
+	}
+	return hex.EncodeToString(buf)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get(SessionIDHeader) != h.sessionID {
+		w.Header().Set(SessionIDHeader, h.sessionID)
+		http.Error(w, "Invalid or missing "+SessionIDHeader+" header, retry with the value above", http.StatusConflict)
+		return
+	}
+
+	var req rpcRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	arguments, err := h.dispatch(r.Context(), req.Method, req.Arguments)
+	resp := rpcResponse{Arguments: arguments, Tag: req.Tag}
+	if err != nil {
+		resp.Result = err.Error()
+	} else {
+		resp.Result = "success"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *Handler) dispatch(ctx context.Context, method string, raw json.RawMessage) (interface{}, error) {
+	switch method {
+	case "session-get":
+		return h.sessionGet(ctx)
+	case "session-stats":
+		return h.sessionStats(ctx)
+	case "torrent-get":
+		return h.torrentGet(ctx, raw)
+	case "torrent-add":
+		return h.torrentAdd(ctx, raw)
+	case "torrent-remove":
+		return h.torrentRemove(ctx, raw)
+	case "torrent-set":
+		return h.torrentSet(ctx, raw)
+	default:
+		return nil, fmt.Errorf("method %q is not implemented by this shim", method)
+	}
+}
+
+// idFor returns hash's Transmission id, assigning the next free one the
+// first time hash is seen.
+func (h *Handler) idFor(hash string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if id, ok := h.hashToID[hash]; ok {
+		return id
+	}
+	id := h.nextID
+	h.nextID++
+	h.idToHash[id] = hash
+	h.hashToID[hash] = id
+	return id
+}
+
+// hashFor returns the hash previously assigned to id, if any.
+func (h *Handler) hashFor(id int) (string, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	hash, ok := h.idToHash[id]
+	return hash, ok
+}
+
+/*
+resolveIDs decodes a torrent-get/torrent-remove/torrent-set "ids" argument,
+which Transmission allows to be absent (every torrent), a single id, or an
+array of ids, each either a Transmission integer id or a qBittorrent hash
+string. Unknown integer ids are silently dropped, since this shim can't
+resolve an id it never assigned. nil, nil means "every torrent".
+*/
+func (h *Handler) resolveIDs(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var ids []interface{}
+	if err := json.Unmarshal(raw, &ids); err != nil {
+		var single interface{}
+		if err := json.Unmarshal(raw, &single); err != nil {
+			return nil, err
+		}
+		ids = []interface{}{single}
+	}
+
+	hashes := make([]string, 0, len(ids))
+	for _, id := range ids {
+		switch v := id.(type) {
+		case float64:
+			if hash, ok := h.hashFor(int(v)); ok {
+				hashes = append(hashes, hash)
+			}
+		case string:
+			hashes = append(hashes, strings.ToLower(v))
+		}
+	}
+	return hashes, nil
+}
+
+// transmissionStatus maps a qBittorrent torrent state to a Transmission
+// torrent-get "status" code.
+func transmissionStatus(state string) int {
+	const (
+		statusStopped = iota
+		statusCheckWait
+		statusCheck
+		statusDownloadWait
+		statusDownload
+		statusSeedWait
+		statusSeed
+	)
+
+	switch state {
+	case "checkingResumeData":
+		return statusCheckWait
+	case "checkingDL", "checkingUP":
+		return statusCheck
+	case "queuedDL":
+		return statusDownloadWait
+	case "queuedUP":
+		return statusSeedWait
+	case "downloading", "metaDL", "forcedDL", "allocating", "stalledDL":
+		return statusDownload
+	case "uploading", "forcedUP", "stalledUP":
+		return statusSeed
+	default: // pausedDL, pausedUP, stoppedDL, stoppedUP, error, missingFiles, unknown
+		return statusStopped
+	}
+}
+
+type transmissionFile struct {
+	Name           string `json:"name"`
+	Length         int64  `json:"length"`
+	BytesCompleted int64  `json:"bytesCompleted"`
+}
+
+type transmissionTrackerStat struct {
+	Announce              string `json:"announce"`
+	Tier                  int    `json:"tier"`
+	LastAnnounceSucceeded bool   `json:"lastAnnounceSucceeded"`
+	LastAnnounceResult    string `json:"lastAnnounceResult"`
+	SeederCount           int    `json:"seederCount"`
+	LeecherCount          int    `json:"leecherCount"`
+}
+
+type transmissionPeer struct {
+	Address      string  `json:"address"`
+	Port         int     `json:"port"`
+	ClientName   string  `json:"clientName"`
+	Progress     float64 `json:"progress"`
+	RateToClient int64   `json:"rateToClient"`
+	RateToPeer   int64   `json:"rateToPeer"`
+}
+
+// transmissionTorrent is the torrent-get response shape. See the package
+// doc comment for the fields deliberately left out.
+type transmissionTorrent struct {
+	ID           int                       `json:"id"`
+	HashString   string                    `json:"hashString"`
+	Name         string                    `json:"name"`
+	Status       int                       `json:"status"`
+	PercentDone  float64                   `json:"percentDone"`
+	RateDownload int64                     `json:"rateDownload"`
+	RateUpload   int64                     `json:"rateUpload"`
+	TotalSize    int64                     `json:"totalSize"`
+	SizeWhenDone int64                     `json:"sizeWhenDone"`
+	UploadRatio  float64                   `json:"uploadRatio"`
+	Eta          int64                     `json:"eta"`
+	DownloadDir  string                    `json:"downloadDir"`
+	IsFinished   bool                      `json:"isFinished"`
+	Files        []transmissionFile        `json:"files,omitempty"`
+	FileStats    []transmissionFile        `json:"fileStats,omitempty"`
+	TrackerStats []transmissionTrackerStat `json:"trackerStats,omitempty"`
+	Peers        []transmissionPeer        `json:"peers,omitempty"`
+}
+
+type torrentGetRequest struct {
+	IDs    json.RawMessage `json:"ids"`
+	Fields []string        `json:"fields"`
+}
+
+func (h *Handler) torrentGet(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	var req torrentGetRequest
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	wanted, err := h.resolveIDs(req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	want := make(map[string]bool, len(wanted))
+	for _, hash := range wanted {
+		want[hash] = true
+	}
+
+	all, err := h.client.GetTorrentListContext(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	needFiles := containsAny(req.Fields, "files", "fileStats")
+	needTrackers := containsAny(req.Fields, "trackerStats")
+	needPeers := containsAny(req.Fields, "peers")
+
+	torrents := make([]transmissionTorrent, 0, len(all))
+	for _, t := range all {
+		if wanted != nil && !want[t.Hash] {
+			continue
+		}
+		torrents = append(torrents, h.toTransmissionTorrent(ctx, t, needFiles, needTrackers, needPeers))
+	}
+	return map[string]interface{}{"torrents": torrents}, nil
+}
+
+func containsAny(fields []string, names ...string) bool {
+	for _, field := range fields {
+		for _, name := range names {
+			if field == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (h *Handler) toTransmissionTorrent(ctx context.Context, t api.BasicTorrent, needFiles bool, needTrackers bool, needPeers bool) transmissionTorrent {
+	out := transmissionTorrent{
+		ID:           h.idFor(t.Hash),
+		HashString:   t.Hash,
+		Name:         t.Name,
+		Status:       transmissionStatus(t.State),
+		PercentDone:  t.Progress,
+		RateDownload: t.Dlspeed,
+		RateUpload:   t.Upspeed,
+		TotalSize:    t.TotalSize,
+		SizeWhenDone: t.TotalSize - t.AmountLeft,
+		UploadRatio:  t.Ratio,
+		Eta:          t.ETA,
+		DownloadDir:  t.SavePath,
+		IsFinished:   t.AmountLeft == 0,
+	}
+
+	if needFiles {
+		if files, err := h.client.GetTorrentFilesContext(ctx, t.Hash); err == nil {
+			stats := make([]transmissionFile, len(files))
+			for i, f := range files {
+				stats[i] = transmissionFile{
+					Name:           f.Name,
+					Length:         f.Size,
+					BytesCompleted: int64(f.Progress * float64(f.Size)),
+				}
+			}
+			out.Files = stats
+			out.FileStats = stats
+		}
+	}
+
+	if needTrackers {
+		if trackers, err := h.client.GetTrackersContext(ctx, t.Hash); err == nil {
+			stats := make([]transmissionTrackerStat, len(trackers))
+			for i, tr := range trackers {
+				stats[i] = transmissionTrackerStat{
+					Announce:              tr.URL,
+					Tier:                  tr.Tier,
+					LastAnnounceSucceeded: tr.Status == 2,
+					LastAnnounceResult:    tr.Msg,
+					SeederCount:           tr.NumSeeds,
+					LeecherCount:          tr.NumLeeches,
+				}
+			}
+			out.TrackerStats = stats
+		}
+	}
+
+	if needPeers {
+		out.Peers = h.torrentPeers(ctx, t.Hash)
+	}
+
+	return out
+}
+
+// torrentPeers decodes the raw sync/torrentPeers body GetTorrentPeers
+// returns into the handful of transmissionPeer fields it carries.
+func (h *Handler) torrentPeers(ctx context.Context, hash string) []transmissionPeer {
+	raw, err := h.client.GetTorrentPeersContext(ctx, hash, "0")
+	if err != nil {
+		return nil
+	}
+
+	var decoded struct {
+		Peers map[string]struct {
+			IP       string  `json:"ip"`
+			Port     int     `json:"port"`
+			Client   string  `json:"client"`
+			Progress float64 `json:"progress"`
+			DlSpeed  int64   `json:"dl_speed"`
+			UpSpeed  int64   `json:"up_speed"`
+		} `json:"peers"`
+	}
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		return nil
+	}
+
+	peers := make([]transmissionPeer, 0, len(decoded.Peers))
+	for _, p := range decoded.Peers {
+		peers = append(peers, transmissionPeer{
+			Address:      p.IP,
+			Port:         p.Port,
+			ClientName:   p.Client,
+			Progress:     p.Progress,
+			RateToClient: p.DlSpeed,
+			RateToPeer:   p.UpSpeed,
+		})
+	}
+	return peers
+}
+
+type torrentAddRequest struct {
+	Filename    string `json:"filename"`
+	MetainfoB64 string `json:"metainfo"`
+	DownloadDir string `json:"download-dir"`
+	Paused      bool   `json:"paused"`
+}
+
+// torrentAdd always reports "torrent-added", never "torrent-duplicate":
+// qBittorrent's add endpoints don't say which one actually happened.
+func (h *Handler) torrentAdd(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	var req torrentAddRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return nil, err
+	}
+
+	opts := api.AddTorrentOptions{SavePath: req.DownloadDir, Paused: req.Paused}
+
+	switch {
+	case req.Filename != "":
+		if _, err := h.client.AddTorrentURLsContext(ctx, []string{req.Filename}, opts); err != nil {
+			return nil, err
+		}
+	case req.MetainfoB64 != "":
+		data, err := base64.StdEncoding.DecodeString(req.MetainfoB64)
+		if err != nil {
+			return nil, fmt.Errorf("decoding metainfo: %w", err)
+		}
+		if _, err := h.client.AddTorrentFileReaderContext(ctx, "torrent-add.torrent", bytes.NewReader(data), opts); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("torrent-add requires filename or metainfo")
+	}
+
+	return map[string]interface{}{
+		"torrent-added": map[string]interface{}{"name": req.Filename},
+	}, nil
+}
+
+type torrentRemoveRequest struct {
+	IDs             json.RawMessage `json:"ids"`
+	DeleteLocalData bool            `json:"delete-local-data"`
+}
+
+func (h *Handler) torrentRemove(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	var req torrentRemoveRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return nil, err
+	}
+
+	hashes, err := h.resolveIDs(req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(hashes) == 0 {
+		return nil, errors.New("torrent-remove requires ids")
+	}
+
+	deleteFiles := "false"
+	if req.DeleteLocalData {
+		deleteFiles = "true"
+	}
+	_, err = h.client.DeleteMultipleContext(ctx, hashes, deleteFiles)
+	return nil, err
+}
+
+type torrentSetRequest struct {
+	IDs            json.RawMessage `json:"ids"`
+	DownloadLimit  *int64          `json:"downloadLimit"`
+	UploadLimit    *int64          `json:"uploadLimit"`
+	Location       *string         `json:"location"`
+	SeedRatioLimit *float64        `json:"seedRatioLimit"`
+}
+
+func (h *Handler) torrentSet(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	var req torrentSetRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return nil, err
+	}
+
+	hashes, err := h.resolveIDs(req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(hashes) == 0 {
+		return nil, errors.New("torrent-set requires ids")
+	}
+
+	if req.DownloadLimit != nil {
+		if _, err := h.client.SetTorrentDownloadLimitContext(ctx, hashes, strconv.FormatInt(*req.DownloadLimit, 10)); err != nil {
+			return nil, err
+		}
+	}
+	if req.UploadLimit != nil {
+		if _, err := h.client.SetTorrentUploadLimitContext(ctx, hashes, strconv.FormatInt(*req.UploadLimit, 10)); err != nil {
+			return nil, err
+		}
+	}
+	if req.Location != nil {
+		if _, err := h.client.SetTorrentLocationContext(ctx, hashes, *req.Location); err != nil {
+			return nil, err
+		}
+	}
+	if req.SeedRatioLimit != nil {
+		// -2 for the seeding time limit means "use the global limit", since
+		// torrent-set's seedRatioLimit has no matching time-based argument.
+		if _, err := h.client.SetTorrentShareLimitContext(ctx, hashes, strconv.FormatFloat(*req.SeedRatioLimit, 'f', -1, 64), "-2"); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// sessionGet answers just enough of session-get for clients that probe it
+// before doing anything else.
+func (h *Handler) sessionGet(ctx context.Context) (interface{}, error) {
+	downloadDir, err := h.client.GetDefaultSavePathContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"rpc-version":       17,
+		"version":           "qbittorrent-go transmissionrpc shim",
+		"download-dir":      downloadDir,
+		"session-id":        h.sessionID,
+		"speed-limit-down":  0,
+		"speed-limit-up":    0,
+		"alt-speed-enabled": false,
+	}, nil
+}
+
+func (h *Handler) sessionStats(ctx context.Context) (interface{}, error) {
+	info, err := h.client.GetTransferInfoContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	torrents, err := h.client.GetTorrentListContext(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	active := 0
+	for _, t := range torrents {
+		switch t.State {
+		case "downloading", "uploading", "forcedDL", "forcedUP", "metaDL":
+			active++
+		}
+	}
+
+	return map[string]interface{}{
+		"torrentCount":       len(torrents),
+		"activeTorrentCount": active,
+		"downloadSpeed":      info.DlInfoSpeed,
+		"uploadSpeed":        info.UpInfoSpeed,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package transmissionrpc
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+func TestIdForAssignsStableIncrementingIDs(t *testing.T) {
+	h := New(nil)
+
+	first := h.idFor("aaaa")
+	second := h.idFor("bbbb")
+	if first == second {
+		t.Fatalf("idFor assigned the same id %d to two different hashes", first)
+	}
+	if second != first+1 {
+		t.Errorf("idFor assigned %d after %d, want %d", second, first, first+1)
+	}
+	if again := h.idFor("aaaa"); again != first {
+		t.Errorf("idFor(%q) = %d on second call, want stable %d", "aaaa", again, first)
+	}
+}
+
+func TestHashForRoundTripsIdFor(t *testing.T) {
+	h := New(nil)
+
+	id := h.idFor("deadbeef")
+	hash, ok := h.hashFor(id)
+	if !ok || hash != "deadbeef" {
+		t.Errorf("hashFor(%d) = (%q, %v), want (\"deadbeef\", true)", id, hash, ok)
+	}
+
+	if _, ok := h.hashFor(id + 1000); ok {
+		t.Error("hashFor reported an id that was never assigned")
+	}
+}
+
+func TestResolveIDsMixedHashesAndIDs(t *testing.T) {
+	h := New(nil)
+	id := h.idFor("deadbeef")
+
+	raw := json.RawMessage(`[` + strconv.Itoa(id) + `, "CAFEBABE"]`)
+	hashes, err := h.resolveIDs(raw)
+	if err != nil {
+		t.Fatalf("resolveIDs returned %v", err)
+	}
+
+	want := []string{"deadbeef", "cafebabe"}
+	if len(hashes) != len(want) {
+		t.Fatalf("resolveIDs = %v, want %v", hashes, want)
+	}
+	for i := range want {
+		if hashes[i] != want[i] {
+			t.Errorf("hashes[%d] = %q, want %q", i, hashes[i], want[i])
+		}
+	}
+}
+
+func TestResolveIDsDropsUnknownID(t *testing.T) {
+	h := New(nil)
+
+	hashes, err := h.resolveIDs(json.RawMessage(`[999]`))
+	if err != nil {
+		t.Fatalf("resolveIDs returned %v", err)
+	}
+	if len(hashes) != 0 {
+		t.Errorf("resolveIDs(unknown id) = %v, want no hashes", hashes)
+	}
+}
+
+func TestResolveIDsNilMeansEveryTorrent(t *testing.T) {
+	h := New(nil)
+
+	hashes, err := h.resolveIDs(nil)
+	if err != nil || hashes != nil {
+		t.Errorf("resolveIDs(nil) = (%v, %v), want (nil, nil)", hashes, err)
+	}
+}
+
+func TestTransmissionStatus(t *testing.T) {
+	cases := map[string]int{
+		"downloading":        4,
+		"pausedDL":           0,
+		"queuedUP":           5,
+		"uploading":          6,
+		"checkingResumeData": 1,
+	}
+	for state, want := range cases {
+		if got := transmissionStatus(state); got != want {
+			t.Errorf("transmissionStatus(%q) = %d, want %d", state, got, want)
+		}
+	}
+}
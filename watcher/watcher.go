@@ -0,0 +1,222 @@
+/*
+Package watcher monitors one or more directories for newly created
+*.torrent files and *.magnet text files (one magnet URI per line) and
+submits them to a qbittorrent-go Client, moving each file to a
+success/failure destination once qBittorrent has accepted or rejected it.
+*/
+package watcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/6ftclaud/qbittorrent-go/modules"
+	"github.com/fsnotify/fsnotify"
+)
+
+/*
+DirConfig configures a single watched directory.
+
+	Path	string	Directory to watch for *.torrent/*.magnet files
+	Options	api.AddTorrentOptions	Defaults applied to every torrent submitted from this directory (category, tags, save path, paused, ratio limit, ...)
+	MoveOnSuccess	string	Directory to move a file to once qBittorrent accepts it. Empty means leave it in place
+	MoveOnFailure	string	Directory to move a file to if submission fails. Empty means leave it in place
+*/
+type DirConfig struct {
+	Path          string
+	Options       api.AddTorrentOptions
+	MoveOnSuccess string
+	MoveOnFailure string
+}
+
+/*
+Config configures a Watcher.
+
+	Dirs	[]DirConfig	Directories to watch, each with its own defaults
+	DebounceWindow	time.Duration	Time to wait after the last filesystem event for a file before submitting it, so an atomic rename (write-then-rename) settles first. Defaults to 2s
+	Logger	api.Logger	Logger used for submission activity. Defaults to a no-op logger
+*/
+type Config struct {
+	Dirs           []DirConfig
+	DebounceWindow time.Duration
+	Logger         api.Logger
+}
+
+// nopLogger discards everything; it's the Logger default when Config.Logger is nil.
+type nopLogger struct{}
+
+func (nopLogger) Debug(args ...interface{}) {}
+func (nopLogger) Info(args ...interface{})  {}
+
+// Watcher submits *.torrent/*.magnet files dropped into its configured
+// directories to a qbittorrent-go Client.
+type Watcher struct {
+	client *api.Client
+	config Config
+
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+}
+
+// New creates a Watcher. Zero-valued fields in cfg fall back to the values
+// documented on Config.
+func New(client *api.Client, cfg Config) *Watcher {
+	if cfg.DebounceWindow <= 0 {
+		cfg.DebounceWindow = 2 * time.Second
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = nopLogger{}
+	}
+	return &Watcher{
+		client: client,
+		config: cfg,
+		timers: make(map[string]*time.Timer),
+	}
+}
+
+// isWatchedFile reports whether name is a file this package submits.
+func isWatchedFile(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	return ext == ".torrent" || ext == ".magnet"
+}
+
+/*
+Run watches every configured directory until ctx is canceled. It first
+scans each directory for files that already matched while the daemon was
+down, submitting them immediately, then watches for new ones.
+*/
+func (w *Watcher) Run(ctx context.Context) error {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer fsw.Close()
+
+	dirByPath := make(map[string]DirConfig, len(w.config.Dirs))
+	for _, dir := range w.config.Dirs {
+		if err := fsw.Add(dir.Path); err != nil {
+			return err
+		}
+		dirByPath[dir.Path] = dir
+
+		entries, err := os.ReadDir(dir.Path)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !isWatchedFile(entry.Name()) {
+				continue
+			}
+			w.config.Logger.Info("Recovering pre-existing file ", entry.Name(), " in ", dir.Path)
+			w.submit(ctx, filepath.Join(dir.Path, entry.Name()), dir)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err, ok := <-fsw.Errors:
+			if !ok {
+				return nil
+			}
+			w.config.Logger.Info("Watcher error: ", err)
+		case event, ok := <-fsw.Events:
+			if !ok {
+				return nil
+			}
+			if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+				continue
+			}
+			if !isWatchedFile(event.Name) {
+				continue
+			}
+			dir, ok := dirByPath[filepath.Dir(event.Name)]
+			if !ok {
+				continue
+			}
+			w.debounce(ctx, event.Name, dir)
+		}
+	}
+}
+
+// debounce (re)starts the submission timer for path, so repeated Write
+// events from an atomic rename only trigger one submission.
+func (w *Watcher) debounce(ctx context.Context, path string, dir DirConfig) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if t, scheduled := w.timers[path]; scheduled {
+		t.Stop()
+	}
+	w.timers[path] = time.AfterFunc(w.config.DebounceWindow, func() {
+		w.mu.Lock()
+		delete(w.timers, path)
+		w.mu.Unlock()
+		w.submit(ctx, path, dir)
+	})
+}
+
+// submit adds path to qBittorrent and moves it to dir's success/failure
+// destination, if configured.
+func (w *Watcher) submit(ctx context.Context, path string, dir DirConfig) {
+	if _, err := os.Stat(path); err != nil {
+		// Already moved or removed by the time the debounce window elapsed.
+		return
+	}
+
+	var err error
+	if strings.ToLower(filepath.Ext(path)) == ".magnet" {
+		err = w.submitMagnet(ctx, path, dir)
+	} else {
+		_, err = w.client.AddTorrentFileContext(ctx, []string{path}, dir.Options)
+	}
+
+	if err != nil {
+		w.config.Logger.Info("Failed to submit ", path, ": ", err)
+		w.move(path, dir.MoveOnFailure)
+		return
+	}
+
+	w.config.Logger.Info("Submitted ", path)
+	w.move(path, dir.MoveOnSuccess)
+}
+
+// submitMagnet reads a .magnet file's newline-separated magnet URIs and
+// submits them as a batch of URLs.
+func (w *Watcher) submitMagnet(ctx context.Context, path string, dir DirConfig) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var urls []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			urls = append(urls, line)
+		}
+	}
+	if len(urls) == 0 {
+		return nil
+	}
+
+	_, err = w.client.AddTorrentURLsContext(ctx, urls, dir.Options)
+	return err
+}
+
+// move relocates path into dest, if dest is set. Failures are logged, not
+// returned: a failed move shouldn't make an otherwise-successful submission
+// look like an error to the caller.
+func (w *Watcher) move(path, dest string) {
+	if dest == "" {
+		return
+	}
+	target := filepath.Join(dest, filepath.Base(path))
+	if err := os.Rename(path, target); err != nil {
+		w.config.Logger.Info("Failed to move ", path, " to ", dest, ": ", err)
+	}
+}
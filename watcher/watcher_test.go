@@ -0,0 +1,65 @@
+package watcher
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api "github.com/6ftclaud/qbittorrent-go/modules"
+)
+
+func TestIsWatchedFile(t *testing.T) {
+	cases := map[string]bool{
+		"ubuntu.torrent": true,
+		"UBUNTU.TORRENT": true,
+		"feed.magnet":    true,
+		"notes.txt":      false,
+		"noext":          false,
+	}
+	for name, want := range cases {
+		if got := isWatchedFile(name); got != want {
+			t.Errorf("isWatchedFile(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+// TestDebounceCoalescesRapidEvents asserts that repeated debounce calls for
+// the same path within the debounce window only result in a single submit.
+func TestDebounceCoalescesRapidEvents(t *testing.T) {
+	var submits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&submits, 1)
+		w.Write([]byte("Ok."))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	torrentPath := filepath.Join(dir, "ubuntu.torrent")
+	if err := os.WriteFile(torrentPath, []byte("fake torrent data"), 0o644); err != nil {
+		t.Fatalf("failed to write fixture torrent: %v", err)
+	}
+
+	client := api.NewClient(server.URL)
+	w := New(client, Config{
+		Dirs:           []DirConfig{{Path: dir}},
+		DebounceWindow: 30 * time.Millisecond,
+	})
+
+	ctx := context.Background()
+	dirCfg := w.config.Dirs[0]
+	for i := 0; i < 5; i++ {
+		w.debounce(ctx, torrentPath, dirCfg)
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if got := atomic.LoadInt32(&submits); got != 1 {
+		t.Errorf("got %d submissions from coalesced events, want 1", got)
+	}
+}